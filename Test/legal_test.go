@@ -1,9 +1,19 @@
 package main
 
 import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io"
+	"io/ioutil"
 	"math"
+	"os"
+	"os/exec"
 	"regexp"
+	"strings"
 	"testing"
+	"text/template"
 
 	"github.com/skreimeyer/legal/pkg/legal"
 )
@@ -155,3 +165,2940 @@ func TestDescription(t *testing.T) {
 	}
 
 }
+
+func TestCoordinateBeginning(t *testing.T) {
+	var mete1, mete2 legal.LinearMete
+	mete1.FromString(`THENCE (1) North 2°02'36" East, 99.88 feet`)
+	mete2.FromString(`THENCE (2) South 2°02'36" West, 99.88 feet`)
+	d := legal.Description{
+		Kind:             "Drainage Easement",
+		Subdivision:      "Witt's Addition",
+		County:           "Pulaski",
+		State:            "Arkansas",
+		StartCoordinates: &[2]float64{1253132.32, 184481.86},
+		Area:             100.0,
+		Unit:             "square feet",
+		Metes:            []legal.Mete{&mete1, &mete2},
+	}
+	result, err := d.Describe()
+	want := "BEGINNING AT A POINT HAVING COORDINATES N 184481.86 E 1253132.32; THENCE NORTH"
+	if err != nil || !strings.Contains(result, want) {
+		t.Errorf("Coordinate beginning should contain %s\nerror: %v\nresult:\n%s", want, err, result)
+	}
+	d.StartCoordinates = nil
+	d.PreTied = true
+	result, err = d.Describe()
+	want = "BEGINNING AT THE POINT OF BEGINNING; THENCE NORTH"
+	if err != nil || !strings.Contains(result, want) {
+		t.Errorf("Pre-tied beginning should contain %s\nerror: %v\nresult:\n%s", want, err, result)
+	}
+}
+
+func TestCommonLine(t *testing.T) {
+	var mete legal.LinearMete
+	err := mete.FromString(`THENCE (2) North 2°02'36" East, 99.88 feet`)
+	if err != nil {
+		t.Error(err)
+	}
+	mete.CommonLine = "Lots 5 and 6"
+	want := `ALONG A LINE COMMON TO LOTS 5 AND 6, NORTH 2°2'36.00" EAST A DISTANCE OF 99.88 FEET`
+	if result := mete.Describe(); result != want {
+		t.Errorf("Common line call\nexpected:%s\nresult:%s", want, result)
+	}
+}
+
+func TestArcGISJSON(t *testing.T) {
+	east := legal.NewLinearMete(math.Pi/2.0, 20.0, "feet")
+	bulb := legal.NewArcMete(math.Pi, 10.0, math.Pi/2.0, "feet", legal.CounterClockwise)
+	west := legal.NewLinearMete(math.Pi*3.0/2.0, 20.0, "feet")
+	south := legal.NewLinearMete(math.Pi, 20.0, "feet")
+	d := legal.Description{Metes: []legal.Mete{&east, bulb, &west, &south}}
+	data, err := d.ArcGISJSON([2]float64{100.0, 200.0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var polygon struct {
+		Rings [][][2]float64 `json:"rings"`
+	}
+	err = json.Unmarshal(data, &polygon)
+	if err != nil {
+		t.Fatalf("ArcGISJSON produced invalid JSON %s: %v", data, err)
+	}
+	if len(polygon.Rings) != 1 {
+		t.Fatalf("ArcGISJSON should have exactly one ring, got %d", len(polygon.Rings))
+	}
+	ring := polygon.Rings[0]
+	if ring[0] != [2]float64{100.0, 200.0} || ring[len(ring)-1] != ring[0] {
+		t.Errorf("ArcGISJSON ring is not closed at the start: first %v last %v", ring[0], ring[len(ring)-1])
+	}
+	// the start, 3 line ends (the last closing the ring) and 32 chords along a 31.42 foot arc
+	if len(ring) != 36 {
+		t.Errorf("ArcGISJSON ring should have 36 points with a densified arc, got %d", len(ring))
+	}
+	// the traverse runs counterclockwise, so the ring is reversed and the arc ends two points in
+	top := ring[2]
+	if math.Abs(top[0]-120.0) > 1e-6 || math.Abs(top[1]-220.0) > 1e-6 {
+		t.Errorf("ArcGISJSON arc should end at (120, 220), got %v", top)
+	}
+	twiceArea := 0.0
+	for i := 1; i < len(ring); i++ {
+		twiceArea += ring[i-1][0]*ring[i][1] - ring[i][0]*ring[i-1][1]
+	}
+	if twiceArea >= 0.0 {
+		t.Errorf("ArcGISJSON exterior ring should be wound clockwise, got a signed area of %v", twiceArea/2.0)
+	}
+}
+
+func TestReparseDescribe(t *testing.T) {
+	var mete1, mete2, mete4 legal.LinearMete
+	mete1.FromString(`THENCE (1) South 87°30'54" East, 5.00 feet`)
+	mete2.FromString(`THENCE (2) North 2°02'36" West, 99.88 feet`)
+	mete3 := legal.NewArcMete(math.Pi*2.0/3.0, 25.0, math.Pi/6.0, "feet", legal.Clockwise)
+	mete4.FromString(`THENCE (4) South 2°02'36.5" West, 12.345 feet`)
+	mete4.CommonLine = "Lots 5 and 6"
+	d := legal.Description{
+		Kind:        "Drainage Easement",
+		Lot:         "11",
+		Subdivision: "Witt's Addition",
+		County:      "Pulaski",
+		State:       "Arkansas",
+		Start:       legal.NorthEast,
+		Area:        100.0,
+		Unit:        "square feet",
+		Metes:       []legal.Mete{&mete1, &mete2, mete3, &mete4},
+	}
+	if err := legal.ReparseDescribe(d); err != nil {
+		t.Errorf("ReparseDescribe failed for a mixed line and arc description: %v", err)
+	}
+	d.Metes = []legal.Mete{mete3, &mete4, &mete1, &mete2}
+	if err := legal.ReparseDescribe(d); err != nil {
+		t.Errorf("ReparseDescribe failed with an arc as the first call: %v", err)
+	}
+}
+
+func TestConvergenceNote(t *testing.T) {
+	var mete1, mete2 legal.LinearMete
+	mete1.FromString(`THENCE (1) North 2°02'36" East, 99.88 feet`)
+	mete2.FromString(`THENCE (2) South 2°02'36" West, 99.88 feet`)
+	d := legal.Description{
+		Kind:             "Drainage Easement",
+		Subdivision:      "Witt's Addition",
+		County:           "Pulaski",
+		State:            "Arkansas",
+		Area:             100.0,
+		Unit:             "square feet",
+		Metes:            []legal.Mete{&mete1, &mete2},
+		ConvergenceAngle: -(1.0 + 15.0/60.0 + 30.0/3600.0) * math.Pi / 180.0,
+	}
+	result, err := d.Describe()
+	want := "DESCRIBED AS FOLLOWS:\n(GRID BEARINGS; CONVERGENCE ANGLE -1°15'30.00\" APPLIED)\nBEGINNING AT"
+	if err != nil || !strings.Contains(result, want) {
+		t.Errorf("Convergence note should contain %s\nerror: %v\nresult:\n%s", want, err, result)
+	}
+	d.ConvergenceAngle = 0.0
+	if result, _ = d.Describe(); strings.Contains(result, "CONVERGENCE") {
+		t.Errorf("Convergence note should be omitted without a convergence angle\nresult:\n%s", result)
+	}
+}
+
+func TestReportComments(t *testing.T) {
+	report := `# surveyed 2023-05-01
+[INSERT PREAMBLE/CAPTION]:
+// field crew 2
+
+THENCE (1) South 2°02'36" West, 99.85 feet; // along fence
+# THENCE (2) North 2°29'06" East, 5.00 feet
+THENCE (2) North 2°02'36" East, 99.88 feet # checked
+
+Containing 637.44 square feet, more or less # grid`
+	metes, area, unit, err := legal.ParseReport(report)
+	if err != nil {
+		t.Fatalf("ParseReport failed on a commented report: %v", err)
+	}
+	if len(metes) != 2 || area != 637.44 || unit != "SQUARE FEET" {
+		t.Errorf("ParseReport with comments returned %d metes, area %v and unit %q", len(metes), area, unit)
+	}
+	var want legal.LinearMete
+	want.FromString(`THENCE (2) North 2°02'36" East, 99.88 feet`)
+	if *metes[1].(*legal.LinearMete) != want {
+		t.Errorf("ParseReport should strip trailing comments\nexpected:%v\nresult:%v", want, metes[1])
+	}
+	custom := "% exported 2023-05-02\nTRACT A:\nTHENCE (1) North 2°02'36\" East, 99.88 feet\n"
+	metes, _, _, err = legal.ParseReportWith(custom, legal.ParseOptions{CommentPrefixes: []string{"%"}})
+	if err != nil || len(metes) != 1 {
+		t.Errorf("ParseReportWith should skip lines with custom comment prefixes, got %d metes and error %v", len(metes), err)
+	}
+}
+
+func TestCallsCSV(t *testing.T) {
+	var mete1, mete2 legal.LinearMete
+	mete1.FromString(`THENCE (1) North 10°15'30" West, 65.00 feet`)
+	mete2.FromString(`THENCE (2) South 2°02'36" West, 99.88 feet`)
+	arc := legal.NewArcMete(math.Pi/2.0, 20.0, math.Pi, "feet", legal.Clockwise)
+	d := legal.Description{Metes: []legal.Mete{&mete1, arc, &mete2}}
+	rows := strings.Split(strings.TrimSpace(d.CallsCSV()), "\n")
+	if len(rows) != 4 {
+		t.Fatalf("CallsCSV should have a header and 3 rows, got %d\n%s", len(rows), d.CallsCSV())
+	}
+	want := []string{
+		"bearing,distance,unit,radius,delta",
+		"N10-15-30W,65.00,FEET,,",
+		"S45-0-0W,31.42,FEET,20.00,90-0-0",
+		"S2-2-36W,99.88,FEET,,",
+	}
+	if !cmpslice(want, rows) {
+		t.Errorf("CallsCSV\nexpected:%v\nresult:%v", want, rows)
+	}
+	// a central angle a thousandth of a second short of 90 degrees rounds up into the minutes and degrees
+	arc = legal.NewArcMete(math.Pi/2.0-0.001/3600.0*math.Pi/180.0, 20.0, math.Pi, "feet", legal.Clockwise)
+	d.Metes = []legal.Mete{arc}
+	if rows = strings.Split(strings.TrimSpace(d.CallsCSV()), "\n"); !strings.HasSuffix(rows[1], ",90-0-0") {
+		t.Errorf("CallsCSV should carry rounded seconds into the minutes, got %s", rows[1])
+	}
+}
+
+func TestClosingLine(t *testing.T) {
+	east := legal.NewLinearMete(math.Pi/2.0, 30.0, "feet")
+	north := legal.NewLinearMete(0.0, 40.0, "feet")
+	d := legal.Description{
+		Kind:        "Drainage Easement",
+		Subdivision: "Witt's Addition",
+		County:      "Pulaski",
+		State:       "Arkansas",
+		Metes:       []legal.Mete{&east, &north},
+		ShowClosing: true,
+	}
+	result, err := d.Describe()
+	// atan(30/40) = 36.869898°
+	want := "\n\n[VERIFICATION ONLY - NOT PART OF THE LEGAL DESCRIPTION] CLOSING: SOUTH 36°52'11.63\" WEST, 50.00 FEET"
+	if err != nil || !strings.HasSuffix(result, want) {
+		t.Errorf("Closing line should end the description with %s\nerror: %v\nresult:\n%s", want, err, result)
+	}
+	// the closure is computed in the unit of the first call, whatever the unit of the last
+	metric := north.ConvertTo(legal.Meters)
+	d.Metes = []legal.Mete{&east, &metric}
+	result, err = d.ClosingLine()
+	if want := "CLOSING: SOUTH 36°52'11.63\" WEST, 50.00 FEET"; err != nil || !strings.HasSuffix(result, want) {
+		t.Errorf("Closing line of a mixed traverse should be stated in its common unit, %s\nerror: %v\nresult: %s", want, err, result)
+	}
+}
+
+func TestSplitAtStation(t *testing.T) {
+	east := legal.NewLinearMete(math.Pi/2.0, 100.0, "feet")
+	curve := legal.NewArcMete(math.Pi/2.0, 50.0, math.Pi/2.0, "feet", legal.CounterClockwise)
+	metes := []legal.Mete{&east, curve}
+	before, after, err := legal.SplitAtStation(metes, 40.0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(before) != 1 || len(after) != 2 {
+		t.Fatalf("SplitAtStation inside the first call should give 1 and 2 calls, got %d and %d", len(before), len(after))
+	}
+	first, second := legal.NewLinearMete(math.Pi/2.0, 40.0, "feet"), legal.NewLinearMete(math.Pi/2.0, 60.0, "feet")
+	if *before[0].(*legal.LinearMete) != first || *after[0].(*legal.LinearMete) != second || after[1] != curve {
+		t.Errorf("SplitAtStation divided incorrectly: before %v after %v", before, after)
+	}
+	whole, err := legal.Coordinates([2]float64{0.0, 0.0}, metes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	split, err := legal.Coordinates([2]float64{0.0, 0.0}, append(before, after...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	end, splitEnd := whole[len(whole)-1], split[len(split)-1]
+	if math.Abs(end[0]-splitEnd[0]) > 1e-9 || math.Abs(end[1]-splitEnd[1]) > 1e-9 {
+		t.Errorf("SplitAtStation changed the traverse end from %v to %v", end, splitEnd)
+	}
+	before, after, err = legal.SplitAtStation(metes, 100.0+25.0*math.Pi/2.0)
+	if err != nil || len(before) != 2 || len(after) != 1 {
+		t.Fatalf("SplitAtStation halfway along the arc should give 2 and 1 calls, got %d, %d and error %v", len(before), len(after), err)
+	}
+	if math.Abs(after[0].Tangent()-math.Pi/4.0) > 1e-9 {
+		t.Errorf("SplitAtStation second half of the arc should start tangent at %v, got %v", math.Pi/4.0, after[0].Tangent())
+	}
+	if _, _, err = legal.SplitAtStation(metes, 500.0); err == nil {
+		t.Errorf("SplitAtStation beyond the end of the traverse should fail")
+	}
+}
+
+// plainMete is a Mete written outside the package, which describes itself in one style only
+type plainMete struct{ legal.LinearMete }
+
+func (m plainMete) Describe() string        { return "ALONG THE FENCE" }
+func (m plainMete) Preamble(float64) string { return "A FENCE CORNER" }
+func (m plainMete) Tangent() float64        { return m.LinearMete.Tangent() }
+
+func TestPlainMete(t *testing.T) {
+	first := legal.NewLinearMete(0.0, 100.0, "feet")
+	second := plainMete{legal.NewLinearMete(math.Pi/2.0, 100.0, "feet")}
+	d := legal.Description{Kind: "Tract", Start: legal.SouthWest, Metes: []legal.Mete{&first, second}, Area: 1.0, Unit: "acres"}
+	d.Format.Precision = &legal.Precision{Distance: 3, Area: 2, Seconds: 2}
+	result, err := d.Describe()
+	if err != nil || !strings.Contains(result, "DUE NORTH A DISTANCE OF 100.000 FEET TO A FENCE CORNER; THENCE ALONG THE FENCE TO") {
+		t.Errorf("A mete without a style should describe itself\nerror: %v\nresult:\n%s", err, result)
+	}
+}
+
+func TestCurveOrder(t *testing.T) {
+	arc := legal.NewArcMete(math.Pi/2.0, 20.0, math.Pi, "FEET", legal.Clockwise)
+	var opts legal.FormatOptions
+	if arc.DescribeWith(opts) != arc.Describe() || arc.PreambleWith(math.Pi, opts) != arc.Preamble(math.Pi) {
+		t.Errorf("Default curve order should match Describe and Preamble")
+	}
+	if !strings.Contains(arc.Preamble(math.Pi), "SAID CURVE HAS A RADIUS OF 20.00 FEET") {
+		t.Errorf("Default curve order should state the radius in the preamble: %s", arc.Preamble(math.Pi))
+	}
+	opts.CurveOrder = legal.DeltaRadiusArc
+	deltaFirst := arc.DescribeWith(opts)
+	opts.CurveOrder = legal.RadiusDeltaArc
+	radiusFirst := arc.DescribeWith(opts)
+	if strings.Contains(arc.PreambleWith(math.Pi, opts), "RADIUS") {
+		t.Errorf("Curve order %v should not state the radius in the preamble: %s", opts.CurveOrder, arc.PreambleWith(math.Pi, opts))
+	}
+	if strings.Index(deltaFirst, "CENTRAL ANGLE") > strings.Index(deltaFirst, "RADIUS") {
+		t.Errorf("DeltaRadiusArc should state the central angle before the radius: %s", deltaFirst)
+	}
+	if strings.Index(radiusFirst, "CENTRAL ANGLE") < strings.Index(radiusFirst, "RADIUS") {
+		t.Errorf("RadiusDeltaArc should state the radius before the central angle: %s", radiusFirst)
+	}
+	for _, call := range []string{deltaFirst, radiusFirst} {
+		if strings.Index(call, "ARC DISTANCE") < strings.Index(call, "RADIUS") {
+			t.Errorf("Arc distance should follow the radius and central angle: %s", call)
+		}
+	}
+}
+
+func TestGeodeticInverse(t *testing.T) {
+	// 0.001° of latitude due north on a sphere of radius 6371008.8 m
+	b, dist := legal.GeodeticInverse(legal.LatLon{Lat: 36.0, Lon: -92.0}, legal.LatLon{Lat: 36.001, Lon: -92.0})
+	if math.Abs(dist-111.195) > 0.001 || math.Abs(b.ToAngle()) > 1e-9 {
+		t.Errorf("GeodeticInverse due north should be 111.195 m at N 0° E, got %v m at %s", dist, b.Describe())
+	}
+	// ~166.8 m north and ~237.6 m east across downtown Little Rock
+	b, dist = legal.GeodeticInverse(legal.LatLon{Lat: 34.7465, Lon: -92.2896}, legal.LatLon{Lat: 34.7480, Lon: -92.2870})
+	if math.Abs(dist-290.26) > 0.01 || math.Abs(b.ToAngle()-54.92537*math.Pi/180.0) > 1e-6 {
+		t.Errorf("GeodeticInverse should be 290.26 m at N 54°55'31\" E, got %v m at %s", dist, b.Describe())
+	}
+}
+
+func TestMeanderSegment(t *testing.T) {
+	var course1, course2, course3, east legal.LinearMete
+	course1.FromString(`THENCE (1) North 10°15'30" West, 65.00 feet`)
+	course2.FromString(`THENCE (2) North 25°00'00" East, 40.00 feet`)
+	course3.FromString(`THENCE (3) North 5°30'00" West, 52.50 feet`)
+	east.FromString(`THENCE (4) South 88°00'00" East, 120.00 feet`)
+	creek := legal.NewMeanderSegment("the centerline of Mill Creek", &course1, &course2, &course3)
+	want := `THE FOLLOWING 3 COURSES ALONG THE CENTERLINE OF MILL CREEK: (1) NORTH 10°15'30.00" WEST A DISTANCE OF 65.00 FEET, (2) NORTH 25°0'0.00" EAST A DISTANCE OF 40.00 FEET, (3) NORTH 5°30'0.00" WEST A DISTANCE OF 52.50 FEET`
+	if result := creek.Describe(); result != want {
+		t.Errorf("Meander\nexpected:%s\nresult:%s", want, result)
+	}
+	d := legal.Description{Metes: []legal.Mete{&east, creek}}
+	if rows := strings.Split(strings.TrimSpace(d.CallsCSV()), "\n"); len(rows) != 5 {
+		t.Errorf("CallsCSV should list each course of a meander, got %d rows", len(rows))
+	}
+	points, err := legal.Coordinates([2]float64{0.0, 0.0}, d.Metes)
+	if err != nil || len(points) != 5 {
+		t.Errorf("Coordinates should include each course of a meander, got %d points and error %v", len(points), err)
+	}
+}
+
+func TestAdjustToArea(t *testing.T) {
+	north := legal.NewLinearMete(0.0, 100.0, "feet")
+	east := legal.NewLinearMete(math.Pi/2.0, 50.0, "feet")
+	south := legal.NewLinearMete(math.Pi, 99.9, "feet")
+	west := legal.NewLinearMete(math.Pi*3.0/2.0, 50.0, "feet")
+	metes := []legal.Mete{&north, &east, &south, &west}
+	adjusted, err := legal.AdjustToArea(metes, 5000.0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the south call falls 0.1 feet short of a 100 x 50 rectangle
+	want := legal.NewLinearMete(math.Pi, 100.0, "feet")
+	got := *adjusted[2].(*legal.LinearMete)
+	if math.Abs(got.Tangent()-want.Tangent()) > 1e-9 || got.Describe() != want.Describe() {
+		t.Errorf("AdjustToArea final call\nexpected:%s\nresult:%s", want.Describe(), got.Describe())
+	}
+	points, err := legal.Coordinates([2]float64{0.0, 0.0}, adjusted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	end := points[len(points)-1]
+	if math.Hypot(end[0], end[1]) > 1e-9 {
+		t.Errorf("AdjustToArea should return a closed traverse, ended at %v", end)
+	}
+	if original := legal.NewLinearMete(math.Pi, 99.9, "feet"); south != original {
+		t.Errorf("AdjustToArea should not modify the original metes")
+	}
+	if _, err = legal.AdjustToArea(metes, 10.0); err == nil {
+		t.Errorf("AdjustToArea should fail when the final call would need a negative length")
+	}
+}
+
+func TestFirstCallConnector(t *testing.T) {
+	var tie, mete1, mete2 legal.LinearMete
+	tie.FromString(`THENCE (1) South 87°30'54" East, 5.00 feet`)
+	mete1.FromString(`THENCE (2) North 2°02'36" East, 99.88 feet`)
+	mete2.FromString(`THENCE (3) South 2°02'36" West, 99.88 feet`)
+	running := "RUNNING THENCE"
+	d := legal.Description{
+		Kind:         "Drainage Easement",
+		Lot:          "11",
+		Subdivision:  "Witt's Addition",
+		County:       "Pulaski",
+		State:        "Arkansas",
+		Start:        legal.NorthEast,
+		Commencement: true,
+		Metes:        []legal.Mete{&tie, &mete1, &mete2},
+		Format:       legal.FormatOptions{FirstCallConnector: &running},
+	}
+	result, err := d.Describe()
+	want := `TO THE POINT OF BEGINNING; RUNNING THENCE NORTH 2°2'36.00" EAST`
+	if err != nil || !strings.Contains(result, want) || strings.Count(result, "THENCE") != 3 {
+		t.Errorf("First call connector should read %s\nerror: %v\nresult:\n%s", want, err, result)
+	}
+	none := ""
+	d.Format.FirstCallConnector = &none
+	result, err = d.Describe()
+	want = `TO THE POINT OF BEGINNING; NORTH 2°2'36.00" EAST`
+	if err != nil || !strings.Contains(result, want) || !strings.Contains(result, "COMMENCING AT THE NORTHEAST CORNER OF SAID LOT 11; THENCE SOUTH") {
+		t.Errorf("Empty first call connector should read %s\nerror: %v\nresult:\n%s", want, err, result)
+	}
+}
+
+func TestBasisOfBearing(t *testing.T) {
+	from := [2]float64{1253132.32, 184481.86}
+	to := [2]float64{1253232.32, 184581.86}
+	want := `BASIS OF BEARING: THE LINE BETWEEN MONUMENT A AND MONUMENT B, TAKEN AS NORTH 45°0'0.00" EAST.`
+	if result := legal.BasisOfBearing(from, to, "Monument A", "Monument B"); result != want {
+		t.Errorf("BasisOfBearing\nexpected:%s\nresult:%s", want, result)
+	}
+	b, dist := legal.Inverse(to, from)
+	if math.Abs(dist-100.0*math.Sqrt2) > 1e-6 || b.Describe() != `SOUTH 45°0'0.00" WEST` {
+		t.Errorf("Inverse should be SOUTH 45°0'0.00\" WEST 141.42, got %s %v", b.Describe(), dist)
+	}
+}
+
+func TestMixedUnits(t *testing.T) {
+	north := legal.NewLinearMete(0.0, 100.0, "feet")
+	east := legal.NewLinearMete(math.Pi/2.0, 50.0, "ft")
+	south := legal.NewLinearMete(math.Pi, 100.0, "Feet")
+	west := legal.NewLinearMete(math.Pi*3.0/2.0, 50.0, "FEET")
+	metes := []legal.Mete{&north, &east, &south, &west}
+	area, err := legal.Area(metes)
+	if err != nil || math.Abs(area-5000.0) > 1e-9 {
+		t.Errorf("Area of a 100 x 50 rectangle with equivalent units should be 5000, got %v and error %v", area, err)
+	}
+	perimeter, err := legal.Perimeter(metes)
+	if err != nil || math.Abs(perimeter-300.0) > 1e-9 {
+		t.Errorf("Perimeter of a 100 x 50 rectangle should be 300, got %v and error %v", perimeter, err)
+	}
+	metric := legal.NewLinearMete(math.Pi*3.0/2.0, 15.24, "meters")
+	metes[3] = &metric
+	points, err := legal.Coordinates([2]float64{0.0, 0.0}, metes)
+	if err != nil || math.Abs(points[4][0]) > 1e-9 {
+		t.Errorf("Coordinates should convert meters to feet and close, got %v and error %v", points, err)
+	}
+	if area, err = legal.Area(metes); err != nil || math.Abs(area-5000.0) > 1e-9 {
+		t.Errorf("Area with a mix of feet and meters should be 5000 square feet, got %v and error %v", area, err)
+	}
+	if perimeter, err = legal.Perimeter(metes); err != nil || math.Abs(perimeter-300.0) > 1e-9 {
+		t.Errorf("Perimeter with a mix of feet and meters should be 300 feet, got %v and error %v", perimeter, err)
+	}
+	d := legal.Description{PreTied: true, Metes: metes, Area: 5000.0, Unit: "square feet"}
+	if result, _ := d.Describe(); !strings.Contains(result, "A DISTANCE OF 15.24 METERS") {
+		t.Errorf("Description should keep the unit of each call\n%s", result)
+	}
+	furlongs := legal.NewLinearMete(math.Pi*3.0/2.0, 0.25, "furlongs")
+	metes[3] = &furlongs
+	if _, err = legal.Coordinates([2]float64{0.0, 0.0}, metes); err == nil {
+		t.Errorf("Coordinates should fail with a unit which can not be converted")
+	}
+	if _, err = legal.Area(metes); err == nil {
+		t.Errorf("Area should fail with a unit which can not be converted")
+	}
+	if _, err = legal.Perimeter(metes); err == nil {
+		t.Errorf("Perimeter should fail with a unit which can not be converted")
+	}
+}
+
+func TestSubtendedChord(t *testing.T) {
+	arc := legal.NewArcMete(math.Pi/3.0, 50.0, 0.0, "FEET", legal.Clockwise)
+	opts := legal.FormatOptions{Chord: legal.SubtendedChord}
+	want := `, SAID CURVE BEING SUBTENDED BY A CHORD BEARING NORTH 30°0'0.00" EAST, A DISTANCE OF 50.00 FEET`
+	if result := arc.DescribeWith(opts); !strings.HasSuffix(result, want) {
+		t.Errorf("Subtended chord should end with %s\nresult:%s", want, result)
+	}
+	if strings.Contains(arc.Describe(), "CHORD") {
+		t.Errorf("Chord should not be stated by default: %s", arc.Describe())
+	}
+}
+
+func TestDiagnostics(t *testing.T) {
+	north := legal.NewLinearMete(0.0, 100.0, "feet")
+	east := legal.NewLinearMete(math.Pi/2.0, 50.0, "feet")
+	south := legal.NewLinearMete(math.Pi, 99.0, "feet")
+	west := legal.NewLinearMete(math.Pi*3.0/2.0, 50.0, "feet")
+	d := legal.Description{
+		Area:  6000.0,
+		Unit:  "square feet",
+		Metes: []legal.Mete{&north, &east, &south, &west},
+	}
+	diag := d.Diagnostics()
+	if len(diag.Errors) != 1 || diag.Errors[0] != "description has no kind" {
+		t.Errorf("Diagnostics should report the missing kind found by Validate, got %v", diag.Errors)
+	}
+	if len(diag.Warnings) != 2 {
+		t.Errorf("Diagnostics should warn of misclosure and area discrepancy, got %v", diag.Warnings)
+	}
+	// a 1 foot misclosure over a 299 foot perimeter, and 50 x 99 = 4950 square feet
+	if math.Abs(diag.ClosureRatio-299.0) > 1e-9 || math.Abs(diag.AreaDiscrepancy-1050.0) > 1e-9 {
+		t.Errorf("Diagnostics should have a closure ratio of 299 and area discrepancy of 1050, got %v and %v", diag.ClosureRatio, diag.AreaDiscrepancy)
+	}
+	data, err := d.DiagnosticsJSON()
+	var decoded legal.Diagnostics
+	if err != nil || json.Unmarshal(data, &decoded) != nil || decoded.ClosureRatio != diag.ClosureRatio {
+		t.Errorf("DiagnosticsJSON should round trip, got %s and error %v", data, err)
+	}
+	d.Metes = nil
+	if diag = d.Diagnostics(); len(diag.Errors) != 1 {
+		t.Errorf("Diagnostics should report an error without metes, got %v", diag.Errors)
+	}
+	south = legal.NewLinearMete(math.Pi, 100.0, "feet")
+	square := []legal.Mete{&north, &east, &south, &west}
+	d = legal.Description{Kind: "TRACT", Area: 5000.0 / 43560.0, Unit: "ACRES", Metes: square}
+	if diag = d.Diagnostics(); len(diag.Errors) != 0 || len(diag.Warnings) != 0 || math.Abs(diag.AreaDiscrepancy) > 1e-9 {
+		t.Errorf("A closed parcel stated in acres should agree with its computed area, got %+v", diag)
+	}
+	d = legal.Description{Kind: "DRAINAGE EASEMENT", Centerline: true, StripWidth: 20.0, Area: 3000.0, Unit: "SQUARE FEET", Metes: square[:2]}
+	if diag = d.Diagnostics(); len(diag.Errors) != 0 || len(diag.Warnings) != 0 || diag.ClosureRatio != 0.0 {
+		t.Errorf("A centerline should not be checked for closure and its area should be that of the strip, got %+v", diag)
+	}
+	d.StripWidth = 0.0
+	if diag = d.Diagnostics(); len(diag.Errors) == 0 {
+		t.Errorf("Diagnostics should report a centerline without a width, got %+v", diag)
+	}
+}
+
+func TestBearingDirectionsFirst(t *testing.T) {
+	want, err := legal.NewBearing(legal.North, legal.West, 10, 15, 30.0)
+	if err != nil {
+		t.Error(err)
+	}
+	for _, sample := range []string{`N W 10°15'30"`, `North West 10°15'30"`, `NW10d15m30s`} {
+		var result legal.Bearing
+		err = result.FromString(sample)
+		if err != nil || result != want {
+			t.Errorf("Bearing from %s\nexpected:%v\nresult:%v error %v", sample, want, result, err)
+		}
+	}
+}
+
+func TestDimensionRecital(t *testing.T) {
+	var mete1, mete2 legal.LinearMete
+	mete1.FromString(`THENCE (1) North 2°02'36" East, 150.00 feet`)
+	mete2.FromString(`THENCE (2) South 2°02'36" West, 150.00 feet`)
+	d := legal.Description{
+		Kind:        "Warranty Deed",
+		Lot:         "11",
+		Subdivision: "Witt's Addition",
+		County:      "Pulaski",
+		State:       "Arkansas",
+		Area:        7500.0,
+		Unit:        "square feet",
+		Metes:       []legal.Mete{&mete1, &mete2},
+		Width:       50.0,
+		Depth:       150.0,
+	}
+	result, err := d.Describe()
+	want := "MORE OR LESS. SAID LOT BEING 50.00 FEET IN WIDTH AND 150.00 FEET IN DEPTH."
+	if err != nil || !strings.HasSuffix(result, want) {
+		t.Errorf("Dimension recital should end with %s\nerror: %v\nresult:\n%s", want, err, result)
+	}
+	d.Depth = 0.0
+	if result, _ = d.Describe(); strings.Contains(result, "IN WIDTH") {
+		t.Errorf("Dimension recital should be omitted without a depth\nresult:\n%s", result)
+	}
+}
+
+func TestStrictParse(t *testing.T) {
+	strict := legal.DefaultParseOptions
+	strict.Strict = true
+	cases := map[string]string{
+		"missing seconds": "caption\nTHENCE (1) North 2°02' East, 99.88 feet\nContaining 637.44 square feet, more or less",
+		"missing units":   "caption\nTHENCE (1) North 2°02'36\" East, 99.88;\nContaining 637.44 square feet, more or less",
+		"unclean area":    "caption\nTHENCE (1) North 2°02'36\" East, 99.88 feet\nContaining 637.44 sq ft more or less",
+	}
+	for name, report := range cases {
+		metes, _, _, err := legal.ParseReport(report)
+		if err != nil || len(metes) != 1 {
+			t.Errorf("Lenient parse should succeed with %s, got %d metes and error %v", name, len(metes), err)
+		}
+		if _, _, _, err = legal.ParseReportWith(report, strict); err == nil {
+			t.Errorf("Strict parse should fail with %s", name)
+		}
+	}
+	var lenient legal.LinearMete
+	lenient.FromString(`THENCE (1) North 2°02' East, 99.88;`)
+	b, _ := legal.NewBearing(legal.North, legal.East, 2, 2, 0.0)
+	want := legal.NewLinearMete(b.ToAngle(), 99.88, "feet")
+	if lenient != want {
+		t.Errorf("Lenient parse should take zero seconds and feet\nexpected:%v\nresult:%v", want, lenient)
+	}
+}
+
+func TestCulDeSac(t *testing.T) {
+	bulb := legal.NewArcMete(math.Pi*3.0/2.0, 50.0, 0.0, "feet", legal.Clockwise)
+	want := `CENTRAL ANGLE OF 270°0'0.00" AN ARC DISTANCE OF 235.62 feet`
+	if result := bulb.Describe(); !strings.Contains(result, want) {
+		t.Errorf("Cul-de-sac arc should describe %s\nresult:%s", want, result)
+	}
+	if math.Abs(bulb.ChordLength()-50.0*math.Sqrt2) > 1e-9 {
+		t.Errorf("Cul-de-sac chord should be 70.71, got %v", bulb.ChordLength())
+	}
+	// the bulb sweeps from the west of its radius point around to the south, closing back on a chord bearing N45°W
+	end := bulb.Endpoint([2]float64{0.0, 0.0})
+	if math.Abs(end[0]-50.0) > 1e-9 || math.Abs(end[1]+50.0) > 1e-9 {
+		t.Errorf("Cul-de-sac arc should end at (50, -50), got %v", end)
+	}
+	closing := legal.NewLinearMete(-math.Pi/4.0, 50.0*math.Sqrt2, "feet")
+	area, err := legal.Area([]legal.Mete{bulb, &closing})
+	// three quarters of the circle plus the right triangle between the chord and the radius point
+	wantArea := math.Pi*2500.0*0.75 + 1250.0
+	if err != nil || math.Abs(area-wantArea) > 1e-6 {
+		t.Errorf("Cul-de-sac area should be %v, got %v and error %v", wantArea, area, err)
+	}
+	points := bulb.Densify([2]float64{0.0, 0.0}, 1.0)
+	last := points[len(points)-1]
+	if len(points) != 236 || math.Abs(last[0]-end[0]) > 1e-9 || math.Abs(last[1]-end[1]) > 1e-9 {
+		t.Errorf("Cul-de-sac densified into %d points ending at %v", len(points), last)
+	}
+	d := legal.Description{Start: legal.North, Metes: []legal.Mete{bulb, &closing}}
+	if err = legal.ReparseDescribe(d); err != nil {
+		t.Errorf("ReparseDescribe failed for a cul-de-sac: %v", err)
+	}
+}
+
+func TestCallTransform(t *testing.T) {
+	var mete1, mete2 legal.LinearMete
+	mete1.FromString(`THENCE (1) North 2°02'36" East, 99.88 feet`)
+	mete2.FromString(`THENCE (2) South 2°02'36" West, 99.88 feet`)
+	mete2.CommonLine = "the Smith tract"
+	d := legal.Description{
+		Kind:        "Drainage Easement",
+		Subdivision: "Witt's Addition",
+		County:      "Pulaski",
+		State:       "Arkansas",
+		Metes:       []legal.Mete{&mete1, &mete2},
+	}
+	var seen []int
+	d.Format.CallTransform = func(index int, text string) string {
+		seen = append(seen, index)
+		return strings.ReplaceAll(text, "THE ", "the ")
+	}
+	result, err := d.Describe()
+	want := `THENCE ALONG A LINE COMMON TO the SMITH TRACT, SOUTH`
+	if err != nil || !strings.Contains(result, want) || len(seen) != 2 || seen[1] != 1 {
+		t.Errorf("Call transform should be applied to each call giving %s\nerror: %v\ncalls: %v\nresult:\n%s", want, err, seen, result)
+	}
+	if !strings.Contains(result, "TO THE POINT OF BEGINNING") {
+		t.Errorf("Call transform should not apply outside of calls\nresult:\n%s", result)
+	}
+}
+
+func TestUnplattedTract(t *testing.T) {
+	var mete1, mete2 legal.LinearMete
+	mete1.FromString(`THENCE (1) North 2°02'36" East, 99.88 feet`)
+	mete2.FromString(`THENCE (2) South 2°02'36" West, 99.88 feet`)
+	d := legal.Description{
+		Kind:   "Temporary Construction Easement",
+		County: "Pulaski",
+		State:  "Arkansas",
+		Start:  legal.SouthWest,
+		Area:   100.0,
+		Unit:   "square feet",
+		Metes:  []legal.Mete{&mete1, &mete2},
+	}
+	result, err := d.Describe()
+	want := "A TRACT OF LAND LYING IN Pulaski COUNTY, Arkansas, BEING MORE PARTICULARLY DESCRIBED AS FOLLOWS:\nBEGINNING AT THE SOUTHWEST CORNER OF SAID TRACT;"
+	if err != nil || !strings.Contains(result, want) || strings.Contains(result, " TO THE CITY") {
+		t.Errorf("Unplatted tract should read %s\nerror: %v\nresult:\n%s", want, err, result)
+	}
+}
+
+func TestClosureBearing(t *testing.T) {
+	north := legal.NewLinearMete(0.0, 100.0, "feet")
+	east := legal.NewLinearMete(math.Pi/2.0, 50.0, "feet")
+	south := legal.NewLinearMete(math.Pi, 99.96, "feet")
+	west := legal.NewLinearMete(math.Pi*3.0/2.0, 49.97, "feet")
+	b, dist := legal.ClosureBearing([2]float64{1000.0, 5000.0}, []legal.Mete{&north, &east, &south, &west})
+	// the traverse ends 0.03 east and 0.04 north of its start, atan(3/4) = 36.869898°
+	want := `NORTH 36°52'11.63" EAST`
+	if math.Abs(dist-0.05) > 1e-9 || b.Describe() != want {
+		t.Errorf("ClosureBearing should be %s, 0.05 got %s, %v", want, b.Describe(), dist)
+	}
+}
+
+func TestBearingWords(t *testing.T) {
+	b, err := legal.NewBearing(legal.South, legal.East, 88, 21, 22.0)
+	if err != nil {
+		t.Error(err)
+	}
+	want := "SOUTH 88 DEGREES 21 MINUTES 22 SECONDS EAST"
+	if result := b.DescribeWords(); result != want {
+		t.Errorf("DescribeWords\nexpected:%s\nresult:%s", want, result)
+	}
+	if b.Describe() != `SOUTH 88°21'22.00" EAST` {
+		t.Errorf("Describe should remain symbolic, got %s", b.Describe())
+	}
+}
+
+func TestFromGeoJSON(t *testing.T) {
+	square := `{"type": "FeatureCollection", "features": [
+		{"type": "Feature", "properties": {}, "geometry": {"type": "Point", "coordinates": [0, 0]}},
+		{"type": "Feature", "properties": {"lot": "11"}, "geometry": {"type": "Polygon", "coordinates": [
+			[[1000, 5000], [1000, 5100], [1100, 5100], [1100, 5000], [1000, 5000]]
+		]}}
+	]}`
+	metes, start, err := legal.FromGeoJSON(strings.NewReader(square))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if start != [2]float64{1000.0, 5000.0} || len(metes) != 4 {
+		t.Fatalf("FromGeoJSON should start at (1000, 5000) with 4 metes, got %v with %d", start, len(metes))
+	}
+	for i, want := range []float64{0.0, math.Pi / 2.0, math.Pi, math.Pi * 3.0 / 2.0} {
+		if angle := math.Mod(metes[i].Tangent()+2.0*math.Pi, 2.0*math.Pi); math.Abs(angle-want) > 1e-9 {
+			t.Errorf("FromGeoJSON side %d should bear %v, got %v", i+1, want, angle)
+		}
+	}
+	perimeter, err := legal.Perimeter(metes)
+	if err != nil || math.Abs(perimeter-400.0) > 1e-9 {
+		t.Errorf("FromGeoJSON square should have a perimeter of 400, got %v and error %v", perimeter, err)
+	}
+	if _, _, err = legal.FromGeoJSON(strings.NewReader(`{"type": "Point", "coordinates": [0, 0]}`)); err == nil {
+		t.Errorf("FromGeoJSON should fail without a polygon")
+	}
+}
+
+func TestAreaVerb(t *testing.T) {
+	var mete1, mete2 legal.LinearMete
+	mete1.FromString(`THENCE (1) North 2°02'36" East, 99.88 feet`)
+	mete2.FromString(`THENCE (2) South 2°02'36" West, 99.88 feet`)
+	d := legal.Description{
+		Kind:        "Drainage Easement",
+		Subdivision: "Witt's Addition",
+		County:      "Pulaski",
+		State:       "Arkansas",
+		Area:        100.0,
+		Unit:        "SQUARE FEET",
+		Metes:       []legal.Mete{&mete1, &mete2},
+	}
+	if result, _ := d.Describe(); !strings.HasSuffix(result, "TO THE POINT OF BEGINNING, CONTAINING 100 SQUARE FEET MORE OR LESS.") {
+		t.Errorf("Area should be introduced by CONTAINING by default\nresult:\n%s", result)
+	}
+	d.Format.AreaVerb = "HAVING AN AREA OF"
+	result, err := d.Describe()
+	want := "TO THE POINT OF BEGINNING, HAVING AN AREA OF 100 SQUARE FEET MORE OR LESS."
+	if err != nil || !strings.HasSuffix(result, want) {
+		t.Errorf("Area verb should read %s\nerror: %v\nresult:\n%s", want, err, result)
+	}
+}
+
+func TestCallDelimiter(t *testing.T) {
+	var mete1, mete2, mete3 legal.LinearMete
+	mete1.FromString(`THENCE (1) North 2°02'36" East, 99.88 feet`)
+	mete2.FromString(`THENCE (2) South 87°30'54" East, 5.00 feet`)
+	mete3.FromString(`THENCE (3) South 2°02'36" West, 99.88 feet`)
+	arc := legal.NewArcMete(math.Pi/2.0, 20.0, math.Pi, "feet", legal.Clockwise)
+	d := legal.Description{
+		Kind:        "Drainage Easement",
+		Subdivision: "Witt's Addition",
+		County:      "Pulaski",
+		State:       "Arkansas",
+		Metes:       []legal.Mete{&mete1, &mete2, arc, &mete3},
+		Format:      legal.FormatOptions{CallDelimiter: "\x1e"},
+	}
+	result, err := d.Describe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	calls := strings.Split(result, "\x1e")
+	if len(calls) != len(d.Metes) {
+		t.Errorf("Delimited description should split into %d calls, got %d\n%q", len(d.Metes), len(calls), calls)
+	}
+	if !strings.HasPrefix(calls[2], "THENCE ") || !strings.Contains(calls[2], "ALONG SAID CURVE") {
+		t.Errorf("Delimited call should begin with its connector, got %q", calls[2])
+	}
+	d.Format.CallDelimiter = ""
+	if prose, _ := d.Describe(); prose != strings.ReplaceAll(result, "\x1e", "") {
+		t.Errorf("Delimiters should be the only difference from prose\n%s", prose)
+	}
+}
+
+func TestAliquotLine(t *testing.T) {
+	var mete legal.LinearMete
+	err := mete.FromString(`THENCE (2) South 88°21'22" East, 1320.00 feet`)
+	if err != nil {
+		t.Error(err)
+	}
+	mete.Aliquot = &legal.AliquotLine{Side: legal.North, Aliquot: "NE 1/4"}
+	want := `ALONG THE NORTH LINE OF THE NE 1/4 OF SAID SECTION, SOUTH 88°21'22.00" EAST A DISTANCE OF 1320.00 FEET`
+	if result := mete.Describe(); result != want {
+		t.Errorf("Aliquot line call\nexpected:%s\nresult:%s", want, result)
+	}
+}
+
+func TestRoundToMinute(t *testing.T) {
+	cases := []struct {
+		deg, min int
+		sec      float64
+		wantDeg  int
+		wantMin  int
+	}{
+		{10, 15, 45.0, 10, 16},
+		{10, 15, 29.99, 10, 15},
+		{10, 59, 30.0, 11, 0},
+	}
+	for _, c := range cases {
+		b, err := legal.NewBearing(legal.North, legal.West, c.deg, c.min, c.sec)
+		if err != nil {
+			t.Error(err)
+		}
+		want, _ := legal.NewBearing(legal.North, legal.West, c.wantDeg, c.wantMin, 0.0)
+		if result := b.RoundToMinute(); result != want {
+			t.Errorf("RoundToMinute of %s\nexpected:%s\nresult:%s", b.Describe(), want.Describe(), result.Describe())
+		}
+	}
+}
+
+func TestFractionalAcre(t *testing.T) {
+	d := legal.Description{Area: 0.25, Unit: "ACRES"}
+	if result, _ := d.AreaText(); result != "ONE-QUARTER (0.25) ACRE" {
+		t.Errorf("Quarter acre should be written in words, got %s", result)
+	}
+	d.Area = 0.3
+	if result, _ := d.AreaText(); result != "0.3 ACRES" {
+		t.Errorf("Non-standard fractions of an acre should be numerals, got %s", result)
+	}
+	d.Area, d.Unit = 0.25, "SQUARE FEET"
+	if result, _ := d.AreaText(); result != "0.25 SQUARE FEET" {
+		t.Errorf("Only acres should be written in words, got %s", result)
+	}
+}
+
+func TestBeginningTies(t *testing.T) {
+	var mete1, mete2 legal.LinearMete
+	mete1.FromString(`THENCE (1) North 2°02'36" East, 99.88 feet`)
+	mete2.FromString(`THENCE (2) South 2°02'36" West, 99.88 feet`)
+	pin, _ := legal.NewBearing(legal.North, legal.East, 45, 10, 5.0)
+	monument, _ := legal.NewBearing(legal.South, legal.West, 12, 0, 30.0)
+	d := legal.Description{
+		Kind:        "Drainage Easement",
+		Lot:         "11",
+		Subdivision: "Witt's Addition",
+		County:      "Pulaski",
+		State:       "Arkansas",
+		Start:       legal.NorthWest,
+		Metes:       []legal.Mete{&mete1, &mete2},
+		BeginningTies: []legal.Tie{
+			{Bearing: pin, Distance: 12.5, Unit: "feet", Monument: `1/2" iron pin`},
+			{Bearing: monument, Distance: 250.25, Unit: "feet", Monument: "concrete monument"},
+		},
+	}
+	result, err := d.Describe()
+	want := `BEGINNING AT THE NORTHWEST CORNER OF SAID LOT 11, SAID POINT OF BEGINNING BEING NORTH 45°10'5.00" EAST, 12.50 FEET FROM A FOUND 1/2" IRON PIN AND SOUTH 12°0'30.00" WEST, 250.25 FEET FROM A FOUND CONCRETE MONUMENT; THENCE`
+	if err != nil || !strings.Contains(result, want) {
+		t.Errorf("Beginning ties should read %s\nerror: %v\nresult:\n%s", want, err, result)
+	}
+	var tie legal.LinearMete
+	tie.FromString(`THENCE (1) North 90°00'00" East, 25.00 feet`)
+	d.Commencement = true
+	d.Metes = []legal.Mete{&tie, &mete1, &mete2}
+	result, err = d.Describe()
+	want = `COMMENCING AT THE NORTHWEST CORNER OF SAID LOT 11; THENCE DUE EAST A DISTANCE OF 25.00 FEET TO THE POINT OF BEGINNING, SAID POINT OF BEGINNING BEING NORTH 45°10'5.00" EAST, 12.50 FEET FROM A FOUND 1/2" IRON PIN AND SOUTH 12°0'30.00" WEST, 250.25 FEET FROM A FOUND CONCRETE MONUMENT; THENCE NORTH`
+	if err != nil || !strings.Contains(result, want) {
+		t.Errorf("Beginning ties should follow the commencement tie %s\nerror: %v\nresult:\n%s", want, err, result)
+	}
+}
+
+func TestParseDescription(t *testing.T) {
+	var mete1, mete2, mete3 legal.LinearMete
+	mete1.FromString(`THENCE (1) North 2°02'36" East, 99.88 feet`)
+	mete2.FromString(`THENCE (2) South 87°57'24" East, 50.00 feet`)
+	mete3.FromString(`THENCE (3) South 2°02'36" West, 99.88 feet`)
+	d := legal.Description{
+		Kind:        "Drainage Easement",
+		Lot:         "11",
+		Block:       "2",
+		Subdivision: "Witt's Addition",
+		City:        "Little Rock",
+		County:      "Pulaski",
+		State:       "Arkansas",
+		Start:       legal.NorthWest,
+		Metes:       []legal.Mete{&mete1, &mete2, &mete3},
+		Area:        4994,
+		Unit:        "square feet",
+	}
+	text, err := d.Describe()
+	if err != nil {
+		t.Fatalf("Failed to describe: %v", err)
+	}
+	parsed, err := legal.ParseDescription(text)
+	if err != nil {
+		t.Fatalf("Failed to parse description: %v\n%s", err, text)
+	}
+	if parsed.Lot != "11" || parsed.Block != "2" || parsed.County != "Pulaski" || parsed.Start != legal.NorthWest || parsed.Area != 4994 {
+		t.Errorf("Header was parsed incorrectly: %+v", parsed)
+	}
+	if len(parsed.Metes) != len(d.Metes) {
+		t.Fatalf("Expected %d metes, got %d", len(d.Metes), len(parsed.Metes))
+	}
+	for i, m := range d.Metes {
+		if want, got := m.Describe(), parsed.Metes[i].Describe(); !strings.EqualFold(want, got) {
+			t.Errorf("Mete %d should parse back as %s, got %s", i+1, want, got)
+		}
+	}
+	d.Area = 90000.0
+	cases := []struct {
+		units []string
+		area  float64
+		unit  string
+	}{
+		{[]string{"SQUARE FEET"}, 90000.0, "SQUARE FEET"},
+		{[]string{"ACRES", "SQUARE FEET"}, 2.07, "ACRES"},
+	}
+	for _, c := range cases {
+		d.Format.AreaUnits = c.units
+		text, err := d.Describe()
+		if err != nil {
+			t.Fatalf("Failed to describe: %v", err)
+		}
+		parsed, err := legal.ParseDescription(text)
+		if err != nil || parsed.Area != c.area || parsed.Unit != c.unit {
+			t.Errorf("Area should parse back as %v %s, got %+v (%v)\n%s", c.area, c.unit, parsed, err, text)
+		}
+	}
+}
+
+func TestPrimeSymbols(t *testing.T) {
+	var mete legal.LinearMete
+	mete.FromString(`THENCE (1) North 2°02'36" East, 99.88 feet`)
+	ascii := mete.DescribeWith(legal.FormatOptions{})
+	if ascii != `NORTH 2°2'36.00" EAST A DISTANCE OF 99.88 FEET` {
+		t.Errorf("ASCII symbols should be the default, got %s", ascii)
+	}
+	prime := mete.DescribeWith(legal.FormatOptions{Symbols: legal.PrimeSymbols})
+	if prime != "NORTH 2°2′36.00″ EAST A DISTANCE OF 99.88 FEET" {
+		t.Errorf("Prime symbols should mark minutes and seconds, got %s", prime)
+	}
+	var b legal.Bearing
+	if err := b.FromString("N 2°2′36.00″ E"); err != nil || b.Describe() != `NORTH 2°2'36.00" EAST` {
+		t.Errorf("Bearings with prime symbols should parse, got %s (%v)", b.Describe(), err)
+	}
+}
+
+func TestContains(t *testing.T) {
+	square := []legal.Mete{}
+	for _, angle := range []float64{0.0, math.Pi / 2.0, math.Pi, 3.0 * math.Pi / 2.0} {
+		mete := legal.NewLinearMete(angle, 100.0, "feet")
+		square = append(square, &mete)
+	}
+	start := [2]float64{1000.0, 2000.0}
+	cases := []struct {
+		point [2]float64
+		want  bool
+	}{
+		{[2]float64{1050.0, 2050.0}, true},
+		{[2]float64{1150.0, 2050.0}, false},
+		{[2]float64{1050.0, 1950.0}, false},
+		{[2]float64{1000.0, 2050.0}, true},
+		{[2]float64{1100.0, 2100.0}, true},
+	}
+	for _, c := range cases {
+		if got := legal.Contains(start, square, c.point); got != c.want {
+			t.Errorf("Contains(%v) should be %v", c.point, c.want)
+		}
+	}
+}
+
+func TestAreaUnits(t *testing.T) {
+	d := legal.Description{
+		Area:   1.0,
+		Unit:   "acres",
+		Format: legal.FormatOptions{AreaUnits: []string{"hectares", "square meters", "square feet", "acres"}},
+	}
+	want := "0.4047 HECTARES (4046.86 SQUARE METERS / 43,560 SQUARE FEET / 1.00 ACRES)"
+	if result, _ := d.AreaText(); result != want {
+		t.Errorf("Area should be stated as %s, got %s", want, result)
+	}
+	d.Format.Precision = &legal.Precision{Distance: 2, Area: 1, Seconds: 2}
+	want = "0.4 HECTARES (4046.9 SQUARE METERS / 43,560.0 SQUARE FEET / 1.0 ACRES)"
+	if result, _ := d.AreaText(); result != want {
+		t.Errorf("Converted areas should be stated to the area precision %s, got %s", want, result)
+	}
+	d.Format.AreaUnits = []string{"furlongs"}
+	if _, err := d.AreaText(); err == nil {
+		t.Errorf("Unrecognized area units should fail to state the area")
+	}
+	if _, err := d.Describe(); err == nil {
+		t.Errorf("Unrecognized area units should fail to describe")
+	}
+	// grouped areas are read back by ParseDescription
+	parcel := exampleDescription(t)
+	parcel.Area, parcel.Unit = 1.0, "acres"
+	parcel.Format.AreaUnits = []string{"square feet", "acres"}
+	for _, precision := range []*legal.Precision{nil, {Distance: 2, Area: 1, Seconds: 2}} {
+		parcel.Format.Precision = precision
+		text, err := parcel.Describe()
+		if err != nil {
+			t.Fatalf("Describe failed: %v", err)
+		}
+		parsed, err := legal.ParseDescription(text)
+		if err != nil {
+			t.Fatalf("ParseDescription failed: %v\n%s", err, text)
+		}
+		if parsed.Area != 43560.0 || parsed.Unit != "SQUARE FEET" {
+			t.Errorf("A grouped area should parse back as 43560 SQUARE FEET, got %v %s\n%s", parsed.Area, parsed.Unit, text)
+		}
+	}
+}
+
+func TestCoursePrefix(t *testing.T) {
+	report := `[INSERT PREAMBLE/CAPTION]:
+Course 1: North 2°02'36" East, 99.88 feet
+Line 2: South 87°57'24" East, 50.00 feet
+Course 3: South 2°02'36" West, 99.88 feet
+Containing 4994.00 square feet, more or less`
+	metes, area, _, err := legal.ParseReport(report)
+	if err != nil {
+		t.Fatalf("ParseReport failed on course prefixed calls: %v", err)
+	}
+	if len(metes) != 3 || area != 4994.0 {
+		t.Fatalf("ParseReport with course prefixes returned %d metes and area %v", len(metes), area)
+	}
+	var want legal.LinearMete
+	want.FromString(`THENCE (1) North 2°02'36" East, 99.88 feet`)
+	if *metes[0].(*legal.LinearMete) != want {
+		t.Errorf("Course prefixed call parsed incorrectly\nexpected:%v\nresult:%v", want, metes[0])
+	}
+}
+
+func TestSVG(t *testing.T) {
+	var mete1, mete2, mete3 legal.LinearMete
+	mete1.FromString(`THENCE (1) North 2°02'36" East, 99.88 feet`)
+	mete2.FromString(`THENCE (2) South 87°57'24" East, 50.00 feet`)
+	mete3.FromString(`THENCE (3) South 2°02'36" West, 99.88 feet`)
+	arc := legal.NewArcMete(math.Pi/2.0, 20.0, 3.0*math.Pi/2.0, "feet", legal.CounterClockwise)
+	d := legal.Description{Metes: []legal.Mete{&mete1, &mete2, &mete3, arc}}
+	svg, err := d.SVG(legal.Point{}, 400, 300)
+	if err != nil {
+		t.Fatalf("Failed to sketch SVG: %v", err)
+	}
+	paths, labels := 0, 0
+	decoder := xml.NewDecoder(strings.NewReader(string(svg)))
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			if err != io.EOF {
+				t.Fatalf("SVG is not well formed: %v\n%s", err, svg)
+			}
+			break
+		}
+		if start, ok := token.(xml.StartElement); ok && start.Name.Local == "path" {
+			paths++
+		}
+		if start, ok := token.(xml.StartElement); ok && start.Name.Local == "text" {
+			labels++
+		}
+	}
+	if paths != 1 {
+		t.Errorf("SVG should have one path, got %d\n%s", paths, svg)
+	}
+	if labels != 5 {
+		t.Errorf("SVG should label four courses and the point of beginning, got %d labels\n%s", labels, svg)
+	}
+	if !strings.Contains(string(svg), " A") || !strings.Contains(string(svg), `width="400" height="300"`) {
+		t.Errorf("SVG should be 400 by 300 pixels and draw the curve as an arc\n%s", svg)
+	}
+}
+
+func TestNonTangentRadial(t *testing.T) {
+	// the radial line runs from the radius point to the start of the curve, square to its tangent
+	cases := []struct {
+		rot  legal.Rotation
+		want string
+	}{
+		{legal.Clockwise, "TO WHICH A RADIAL LINE BEARS NORTH 45°0'0.00\" WEST"},
+		{legal.CounterClockwise, "TO WHICH A RADIAL LINE BEARS SOUTH 45°0'0.00\" EAST"},
+	}
+	for _, c := range cases {
+		arc := legal.NewArcMete(math.Pi/2.0, 100.0, math.Pi/4.0, "feet", c.rot)
+		result := arc.Preamble(math.Pi / 2.0)
+		if !strings.HasSuffix(result, c.want) {
+			t.Errorf("Radial line of a non-tangent curve is wrong\nexpected: %s\nresult: %s", c.want, result)
+		}
+	}
+}
+
+func TestRadiusPoint(t *testing.T) {
+	line := legal.NewLinearMete(0.0, 50.0, "feet")
+	arc := legal.NewArcMete(math.Pi/2.0, 100.0, 0.0, "feet", legal.Clockwise)
+	if result := arc.RadiusPoint([2]float64{1000.0, 2050.0}); result != "THE RADIUS POINT OF SAID CURVE BEING N 2050.00, E 1100.00" {
+		t.Errorf("Radius point should be east of a clockwise curve heading north, got %s", result)
+	}
+	d := legal.Description{
+		StartCoordinates: &[2]float64{1000.0, 2000.0},
+		Metes:            []legal.Mete{&line, arc},
+		Format:           legal.FormatOptions{RadiusPoint: true},
+	}
+	result, err := d.Describe()
+	if err != nil || !strings.Contains(result, "THE RADIUS POINT OF SAID CURVE BEING N 2050.00, E 1100.00") {
+		t.Errorf("Description should state the radius point of the curve\nerror: %v\nresult:\n%s", err, result)
+	}
+	tie := legal.NewLinearMete(math.Pi/2.0, 30.0, "feet")
+	d.Commencement = true
+	d.Metes = []legal.Mete{&tie, &line, arc}
+	result, err = d.Describe()
+	if err != nil || !strings.Contains(result, "THE RADIUS POINT OF SAID CURVE BEING N 2050.00, E 1100.00") {
+		t.Errorf("Radius points should be located from the point of beginning rather than the commencement\nerror: %v\nresult:\n%s", err, result)
+	}
+	segments, err := d.Segments()
+	if err != nil || segments[0].End != (legal.Point{X: 1000.0, Y: 2000.0}) || segments[1].End != (legal.Point{X: 1000.0, Y: 2050.0}) {
+		t.Errorf("The commencement tie should end at the point of beginning, got %+v (%v)", segments, err)
+	}
+	if points, err := d.Coordinates(); err != nil || points[1] != (legal.Point{X: 0.0, Y: 50.0}) {
+		t.Errorf("Coordinates should walk the boundary from the point of beginning, got %v (%v)", points, err)
+	}
+	paces := legal.NewLinearMete(0.0, 10.0, "paces")
+	d.Metes = []legal.Mete{&tie, &line, &paces, arc}
+	if _, err := d.Call(3); err == nil {
+		t.Errorf("A curve which can not be located should fail to state its radius point")
+	}
+	d.Metes = []legal.Mete{&line, arc}
+	d.Commencement = false
+	d.StartCoordinates = nil
+	if _, err := d.Describe(); err == nil {
+		t.Errorf("Radius points without start coordinates should fail to describe")
+	}
+}
+
+func TestDescriptionCoordinates(t *testing.T) {
+	line := legal.NewLinearMete(math.Pi/2.0, 100.0, "feet")
+	arc := legal.NewArcMete(math.Pi/2.0, 50.0, math.Pi/2.0, "feet", legal.CounterClockwise)
+	d := legal.Description{Metes: []legal.Mete{&line, arc}}
+	points, err := d.Coordinates()
+	if err != nil {
+		t.Fatalf("Failed to compute coordinates: %v", err)
+	}
+	want := []legal.Point{{X: 0.0, Y: 0.0}, {X: 100.0, Y: 0.0}, {X: 150.0, Y: 50.0}}
+	if len(points) != len(want) {
+		t.Fatalf("Expected %d points, got %v", len(want), points)
+	}
+	for i := range want {
+		if math.Abs(points[i].X-want[i].X) > 1e-9 || math.Abs(points[i].Y-want[i].Y) > 1e-9 {
+			t.Errorf("Vertex %d should be %v, got %v", i, want[i], points[i])
+		}
+	}
+}
+
+func TestClosure(t *testing.T) {
+	north := legal.NewLinearMete(0.0, 100.0, "feet")
+	east := legal.NewLinearMete(math.Pi/2.0, 100.0, "feet")
+	south := legal.NewLinearMete(math.Pi, 100.0, "feet")
+	west := legal.NewLinearMete(3.0*math.Pi/2.0, 99.96, "feet")
+	d := legal.Description{Metes: []legal.Mete{&north, &east, &south, &west}}
+	dx, dy, linear, precision, err := d.Closure()
+	if err != nil {
+		t.Fatalf("Failed to compute closure: %v", err)
+	}
+	if math.Abs(dx+0.04) > 1e-9 || math.Abs(dy) > 1e-9 || math.Abs(linear-0.04) > 1e-9 || math.Abs(precision-9999.0) > 1e-6 {
+		t.Errorf("Closure should be (-0.04, 0) for 0.04 at 1:9999, got (%v, %v) for %v at 1:%v", dx, dy, linear, precision)
+	}
+	empty := legal.Description{}
+	if _, _, _, _, err := empty.Closure(); err == nil {
+		t.Errorf("Closure of an empty boundary should be an error")
+	}
+}
+
+func TestAreaCheck(t *testing.T) {
+	// a 100 foot square with its east side bowed out by a semicircle
+	north := legal.NewLinearMete(0.0, 100.0, "feet")
+	east := legal.NewLinearMete(math.Pi/2.0, 100.0, "feet")
+	arc := legal.NewArcMete(math.Pi, 50.0, math.Pi/2.0, "feet", legal.Clockwise)
+	west := legal.NewLinearMete(3.0*math.Pi/2.0, 100.0, "feet")
+	want := 10000.0 + math.Pi*50.0*50.0/2.0
+	d := legal.Description{Metes: []legal.Mete{&north, &east, arc, &west}, Area: 13927.0}
+	computed, diff, ok, err := d.AreaCheck(1.0)
+	if err != nil {
+		t.Fatalf("Failed to check area: %v", err)
+	}
+	if math.Abs(computed-want) > 1e-6 || math.Abs(diff-(13927.0-want)) > 1e-6 || !ok {
+		t.Errorf("Area should compute as %v and agree within a square foot, got %v differing by %v (ok %v)", want, computed, diff, ok)
+	}
+	d.Area = 13972.0
+	if _, _, ok, _ := d.AreaCheck(1.0); ok {
+		t.Errorf("Transposed area digits should not agree with the geometry")
+	}
+	d.Area, d.Unit = 0.32, "ACRES"
+	computed, _, ok, err = d.AreaCheck(0.01)
+	if acres := want * 0.09290304 / 4046.8564224; err != nil || math.Abs(computed-acres) > 1e-9 || !ok {
+		t.Errorf("Area stated in acres should be compared in acres, got %v (ok %v, %v)", computed, ok, err)
+	}
+}
+
+func TestDirectionFromAngle(t *testing.T) {
+	const sector = math.Pi / 4.0
+	const epsilon = 1e-9
+	directions := []legal.Direction{legal.North, legal.NorthEast, legal.East, legal.SouthEast, legal.South, legal.SouthWest, legal.West, legal.NorthWest}
+	for i, want := range directions {
+		center := float64(i) * sector
+		next := directions[(i+1)%8]
+		cases := []struct {
+			angle float64
+			want  legal.Direction
+		}{
+			{center, want},
+			{center - 2*math.Pi, want},
+			{center + 2*math.Pi, want},
+			{center + sector/2.0 - epsilon, want},
+			{center - sector/2.0 + epsilon, want},
+			{center + sector/2.0, next},
+			{center + sector/2.0 + epsilon, next},
+		}
+		for _, c := range cases {
+			if got := legal.DirectionFromAngle(c.angle); got != c.want {
+				t.Errorf("DirectionFromAngle(%v) should be %s, got %s", c.angle, c.want.Describe(), got.Describe())
+			}
+		}
+	}
+}
+
+func TestAzimuth(t *testing.T) {
+	const epsilon = 1e-9
+	for _, azimuth := range []float64{0.0, 10.2575, 90.0, 135.5, 180.0, 225.25, 270.0, 359.75} {
+		var b legal.Bearing
+		b.FromAzimuth(azimuth)
+		if result := b.Azimuth(); math.Abs(result-azimuth) > epsilon {
+			t.Errorf("Azimuth %v should round-trip, got %v (%s)", azimuth, result, b.Describe())
+		}
+	}
+	sw, _ := legal.NewBearing(legal.South, legal.West, 45, 30, 0.0)
+	if result := sw.Azimuth(); math.Abs(result-225.5) > epsilon {
+		t.Errorf("S 45°30' W should be an azimuth of 225.5, got %v", result)
+	}
+	nw, _ := legal.NewBearing(legal.North, legal.West, 10, 0, 0.0)
+	if result := nw.Azimuth(); math.Abs(result-350.0) > epsilon {
+		t.Errorf("N 10° W should be an azimuth of 350, got %v", result)
+	}
+}
+
+func TestBearingJSON(t *testing.T) {
+	b, _ := legal.NewBearing(legal.North, legal.West, 10, 15, 30.0)
+	data, err := json.Marshal(b)
+	if err != nil {
+		t.Fatalf("Failed to marshal bearing: %v", err)
+	}
+	if string(data) != `{"primary":"NORTH","deg":10,"min":15,"sec":30,"secondary":"WEST"}` {
+		t.Errorf("Unexpected JSON for bearing: %s", data)
+	}
+	var result legal.Bearing
+	if err := json.Unmarshal(data, &result); err != nil || result != b {
+		t.Errorf("Bearing should round-trip through JSON, got %s (%v)", result.Describe(), err)
+	}
+	invalid := []string{
+		`{"primary":"NORTH","deg":91,"min":0,"sec":0,"secondary":"WEST"}`,
+		`{"primary":"NORTH","deg":10,"min":0,"sec":-1,"secondary":"WEST"}`,
+		`{"primary":"EAST","deg":10,"min":0,"sec":0,"secondary":"WEST"}`,
+		`{"primary":"NORTH","deg":10,"min":0,"sec":0,"secondary":"UP"}`,
+	}
+	for _, src := range invalid {
+		if err := json.Unmarshal([]byte(src), &result); err == nil {
+			t.Errorf("Invalid bearing %s should fail to unmarshal", src)
+		}
+	}
+}
+
+func TestGeoJSON(t *testing.T) {
+	north := legal.NewLinearMete(0.0, 100.0, "feet")
+	east := legal.NewLinearMete(math.Pi/2.0, 100.0, "feet")
+	south := legal.NewLinearMete(math.Pi, 100.0, "feet")
+	west := legal.NewLinearMete(3.0*math.Pi/2.0, 100.0, "feet")
+	d := legal.Description{Kind: "Drainage Easement", Lot: "11", Subdivision: "Witt's Addition", Metes: []legal.Mete{&north, &east, &south, &west}}
+	data, err := d.GeoJSON(legal.Point{X: 1000.0, Y: 2000.0})
+	if err != nil {
+		t.Fatalf("Failed to encode GeoJSON: %v", err)
+	}
+	var feature struct {
+		Type     string `json:"type"`
+		Geometry struct {
+			Type        string         `json:"type"`
+			Coordinates [][][2]float64 `json:"coordinates"`
+		} `json:"geometry"`
+		Properties map[string]string `json:"properties"`
+	}
+	if err := json.Unmarshal(data, &feature); err != nil {
+		t.Fatalf("GeoJSON is not valid JSON: %v", err)
+	}
+	if feature.Type != "Feature" || feature.Geometry.Type != "Polygon" || feature.Properties["lot"] != "11" || feature.Properties["kind"] != "Drainage Easement" {
+		t.Errorf("Unexpected GeoJSON feature: %s", data)
+	}
+	ring := feature.Geometry.Coordinates[0]
+	if len(ring) != 5 || ring[0] != ring[4] || ring[0] != [2]float64{1000.0, 2000.0} {
+		t.Errorf("Ring should be closed and begin at the origin: %v", ring)
+	}
+	// the traverse runs clockwise, so the ring must be reversed to run counterclockwise
+	if ring[1][0] < 1099.0 || math.Abs(ring[1][1]-2000.0) > 1e-9 {
+		t.Errorf("Ring should be wound counterclockwise: %v", ring)
+	}
+}
+
+func TestDXF(t *testing.T) {
+	// a 100 foot square with its east side bowed out by a clockwise semicircle
+	north := legal.NewLinearMete(0.0, 100.0, "feet")
+	east := legal.NewLinearMete(math.Pi/2.0, 100.0, "feet")
+	arc := legal.NewArcMete(math.Pi, 50.0, math.Pi/2.0, "feet", legal.Clockwise)
+	west := legal.NewLinearMete(3.0*math.Pi/2.0, 100.0, "feet")
+	d := legal.Description{Metes: []legal.Mete{&north, &east, arc, &west}}
+	data, err := d.DXF(legal.Point{X: 0.0, Y: 0.0})
+	if err != nil {
+		t.Fatalf("Failed to write DXF: %v", err)
+	}
+	dxf := string(data)
+	if !strings.Contains(dxf, "0\nLWPOLYLINE\n") || !strings.Contains(dxf, "90\n4\n70\n1\n") || !strings.HasSuffix(dxf, "0\nEOF\n") {
+		t.Errorf("DXF should hold a closed polyline of four vertices:\n%s", dxf)
+	}
+	// the arc begins at the third vertex and a clockwise semicircle has a bulge of -1
+	if !strings.Contains(dxf, "10\n100.000000\n20\n100.000000\n42\n-1.000000\n") || strings.Count(dxf, "\n42\n") != 1 {
+		t.Errorf("DXF should give the arc a bulge of -1:\n%s", dxf)
+	}
+}
+
+func TestParseCivil3D(t *testing.T) {
+	report := `Parcel: LOT 11
+Course: N 0°00'00" E Length: 100.00'
+Curve: Length=78.54', Radius=50.00', Delta=90°00'00", Chord Length=70.71', Chord Bearing=N 45°00'00" E
+Course: S 90°00'00" E Length: 50.00'
+Area: 5000.00 Sq. Ft.`
+	metes, err := legal.ParseCivil3D(report)
+	if err != nil {
+		t.Fatalf("Failed to parse Civil 3D report: %v", err)
+	}
+	if len(metes) != 3 {
+		t.Fatalf("Expected 3 metes, got %d", len(metes))
+	}
+	want := legal.NewLinearMete(0.0, 100.0, "feet")
+	if *metes[0].(*legal.LinearMete) != want {
+		t.Errorf("Course parsed incorrectly\nexpected:%v\nresult:%v", want, metes[0])
+	}
+	arc, ok := metes[1].(*legal.ArcMete)
+	if !ok {
+		t.Fatalf("Curve should parse as an arc, got %T", metes[1])
+	}
+	if math.Abs(arc.Tangent()) > 1e-9 || math.Abs(arc.ArcLength()-25.0*math.Pi) > 1e-9 || math.Abs(arc.ChordAngle()-math.Pi/4.0) > 1e-9 {
+		t.Errorf("Curve should turn clockwise from north through 90°, got tangent %v, length %v and chord %v", arc.Tangent(), arc.ArcLength(), arc.ChordAngle())
+	}
+	if _, err := legal.ParseCivil3D("Curve: Length=78.54', Delta=90°00'00\""); err == nil {
+		t.Errorf("Curves without a radius should fail to parse")
+	}
+}
+
+func TestReportCurves(t *testing.T) {
+	report, err := ioutil.ReadFile("../example.txt")
+	if err != nil {
+		t.Fatalf("Failed to read fixture report: %v", err)
+	}
+	metes, _, _, err := legal.ParseReport(string(report))
+	if err != nil {
+		t.Fatalf("ParseReport failed on a report with curves: %v", err)
+	}
+	if len(metes) != 6 {
+		t.Fatalf("Expected 6 metes, got %d", len(metes))
+	}
+	delta := (90.0 + 26.0/60.0 + 30.0/3600.0) * math.Pi / 180.0
+	// a tangent curve continuing the bearing of the call before it and turning right, concave northwesterly
+	first := legal.NewArcMete(delta, 20.0, metes[0].Tangent(), "FEET", legal.Clockwise)
+	// a non-tangent curve whose radial line bears South 2°29'06" West from its radius point, concave northwesterly
+	radial, _ := legal.NewBearing(legal.South, legal.West, 2, 29, 6.0)
+	second := legal.NewArcMete(delta, 15.0, radial.ToAngle()-math.Pi/2.0, "FEET", legal.CounterClockwise)
+	for i, want := range map[int]*legal.ArcMete{1: first, 3: second} {
+		arc, ok := metes[i].(*legal.ArcMete)
+		if !ok {
+			t.Errorf("Mete %d should be an arc, got %T", i+1, metes[i])
+			continue
+		}
+		if math.Abs(arc.Tangent()-want.Tangent()) > 1e-9 || math.Abs(arc.ArcLength()-want.ArcLength()) > 1e-9 || arc.Concavity() != legal.NorthWest || arc.Describe() != want.Describe() {
+			t.Errorf("Mete %d parsed incorrectly\nexpected: %s\nresult: %s", i+1, want.Describe(), arc.Describe())
+		}
+	}
+}
+
+func TestDueBearings(t *testing.T) {
+	cases := []struct {
+		angle float64
+		want  string
+	}{
+		{0.0, "DUE NORTH"},
+		{math.Pi / 2.0, "DUE EAST"},
+		{math.Pi, "DUE SOUTH"},
+		{3.0 * math.Pi / 2.0, "DUE WEST"},
+	}
+	for _, c := range cases {
+		var b legal.Bearing
+		b.FromAngle(c.angle)
+		if result := b.Describe(); result != c.want {
+			t.Errorf("Bearing at %v should be %s, got %s", c.angle, c.want, result)
+		}
+		var parsed legal.Bearing
+		if err := parsed.FromString(c.want); err != nil || math.Abs(math.Remainder(parsed.ToAngle()-c.angle, 2.0*math.Pi)) > 1e-12 {
+			t.Errorf("%s should parse to %v, got %v (%v)", c.want, c.angle, parsed.ToAngle(), err)
+		}
+	}
+	mete := legal.NewLinearMete(math.Pi/2.0, 100.0, "feet")
+	if result := mete.Describe(); result != "DUE EAST A DISTANCE OF 100.00 FEET" {
+		t.Errorf("Due east call described incorrectly: %s", result)
+	}
+	for _, s := range []string{"DUE NORTHEAST", "DUE WESTERLY", "DUE N 45 E"} {
+		var b legal.Bearing
+		if err := b.FromString(s); err == nil {
+			t.Errorf("%s should not parse as a due bearing, got %s", s, b.Describe())
+		}
+	}
+}
+
+func TestChordBears(t *testing.T) {
+	arc := legal.NewArcMete(math.Pi/2.0, 25.0, 0.0, "FEET", legal.Clockwise)
+	opts := legal.FormatOptions{Chord: legal.ChordBears}
+	want := `NORTHEASTERLY ALONG SAID CURVE THROUGH A CENTRAL ANGLE OF 90°0'0.00" AN ARC DISTANCE OF 39.27 FEET, THE CHORD OF WHICH BEARS NORTH 45°0'0.00" EAST A DISTANCE OF 35.36 FEET`
+	if result := arc.DescribeWith(opts); result != want {
+		t.Errorf("Chord clause should read\n%s\nresult:\n%s", want, result)
+	}
+}
+
+func TestBearingRadiansRoundTrip(t *testing.T) {
+	cases := []struct {
+		primary, secondary legal.Direction
+		deg, min           int
+		sec                float64
+	}{
+		{legal.North, legal.East, 30, 1, 1.0},
+		{legal.South, legal.East, 87, 30, 54.0},
+		{legal.South, legal.West, 45, 10, 10.0},
+		{legal.North, legal.West, 2, 29, 6.0},
+		{legal.North, legal.West, 89, 59, 59.99},
+	}
+	for _, c := range cases {
+		want, _ := legal.NewBearing(c.primary, c.secondary, c.deg, c.min, c.sec)
+		var result legal.Bearing
+		result.FromAngle(want.ToAngle())
+		if result.Describe() != want.Describe() {
+			t.Errorf("%s should survive a round trip through radians, got %s", want.Describe(), result.Describe())
+		}
+		data, _ := json.Marshal(result)
+		var parts struct {
+			Deg, Min int
+			Sec      float64
+		}
+		json.Unmarshal(data, &parts)
+		if parts.Deg != c.deg || parts.Min != c.min || math.Abs(parts.Sec-c.sec) > 1e-6 {
+			t.Errorf("%s should keep its DMS values through radians, got %s", want.Describe(), data)
+		}
+	}
+	almost, _ := legal.NewBearing(legal.North, legal.East, 10, 59, 59.999)
+	if result := almost.Describe(); result != `NORTH 11°0'0.00" EAST` {
+		t.Errorf("Seconds rounding to 60 should carry into the minutes, got %s", result)
+	}
+}
+
+func TestUnitConversion(t *testing.T) {
+	mete := legal.NewLinearMete(0.0, 1000.0, "meters")
+	feet := mete.ConvertTo(legal.Feet)
+	if result := feet.Describe(); result != "DUE NORTH A DISTANCE OF 3280.84 FEET" {
+		t.Errorf("1000 meters should be 3280.84 feet, got %s", result)
+	}
+	survey := mete.ConvertTo(legal.USSurveyFeet)
+	if result := survey.Describe(); result != "DUE NORTH A DISTANCE OF 3280.83 US SURVEY FEET" {
+		t.Errorf("1000 meters should be 3280.83 US survey feet, got %s", result)
+	}
+	chain := legal.NewLinearMete(0.0, 1.0, "chains")
+	if result := chain.ConvertTo(legal.Rods); result.Describe() != "DUE NORTH A DISTANCE OF 4.00 RODS" {
+		t.Errorf("A chain should be four rods, got %s", result.Describe())
+	}
+	unknown := legal.NewLinearMete(0.0, 1.0, "cubits")
+	if unknown.ConvertTo(legal.Meters) != unknown {
+		t.Errorf("Metes in unrecognized units should not be converted")
+	}
+	if sqft := legal.ConvertArea(1.0, legal.Chains, legal.USSurveyFeet); math.Abs(sqft-4356.0) > 1e-9 {
+		t.Errorf("A square chain should be 4356 square survey feet, got %v", sqft)
+	}
+	if sqm := legal.ConvertArea(43560.0, legal.USSurveyFeet, legal.Meters); math.Abs(sqm-4046.8726) > 1e-4 {
+		t.Errorf("A survey acre should be 4046.8726 square meters, got %v", sqm)
+	}
+	if u, ok := legal.UnitFromString("vrs"); !ok || u != legal.Varas {
+		t.Errorf("vrs should be recognized as varas")
+	}
+}
+
+func TestCommencementTie(t *testing.T) {
+	var tie, mete1, mete2 legal.LinearMete
+	tie.FromString(`THENCE (1) South 87°30'54" East, 5.00 feet`)
+	mete1.FromString(`THENCE (2) North 2°02'36" East, 99.88 feet`)
+	mete2.FromString(`THENCE (3) South 2°02'36" West, 99.88 feet`)
+	d := legal.Description{
+		Kind:         "Drainage Easement",
+		Lot:          "11",
+		Subdivision:  "Witt's Addition",
+		County:       "Pulaski",
+		State:        "Arkansas",
+		Start:        legal.NorthEast,
+		Commencement: true,
+		Metes:        []legal.Mete{&tie, &mete1, &mete2},
+	}
+	result, err := d.Describe()
+	want := `COMMENCING AT THE NORTHEAST CORNER OF SAID LOT 11; THENCE SOUTH 87°30'54.00" EAST A DISTANCE OF 5.00 FEET TO THE POINT OF BEGINNING; THENCE NORTH 2°2'36.00" EAST`
+	if err != nil || !strings.Contains(result, want) {
+		t.Errorf("Commencement tie should end at the point of beginning\nexpected: %s\nerror: %v\nresult:\n%s", want, err, result)
+	}
+	arc := legal.NewArcMete(math.Pi/2.0, 25.0, 0.0, "feet", legal.Clockwise)
+	d.Metes = []legal.Mete{&tie, arc, &mete2}
+	result, err = d.Describe()
+	want = `TO THE POINT OF BEGINNING, SAID POINT BEING THE BEGINNING OF A NON-TANGENT CURVE CONCAVE SOUTHEASTERLY`
+	if err != nil || !strings.Contains(result, want) {
+		t.Errorf("Commencement tie to a curve should state the point of beginning\nexpected: %s\nerror: %v\nresult:\n%s", want, err, result)
+	}
+}
+
+func TestReverse(t *testing.T) {
+	// a 100 foot square with its east side bowed out by a clockwise semicircle
+	north := legal.NewLinearMete(0.0, 100.0, "feet")
+	east := legal.NewLinearMete(math.Pi/2.0, 100.0, "feet")
+	arc := legal.NewArcMete(math.Pi, 50.0, math.Pi/2.0, "feet", legal.Clockwise)
+	west := legal.NewLinearMete(3.0*math.Pi/2.0, 100.0, "feet")
+	d := legal.Description{Start: legal.SouthWest, Metes: []legal.Mete{&north, &east, arc, &west}}
+	reversed, err := d.Reverse()
+	if err != nil {
+		t.Fatalf("Failed to reverse description: %v", err)
+	}
+	forward, _ := d.Coordinates()
+	backward, err := reversed.Coordinates()
+	if err != nil || len(backward) != len(forward) {
+		t.Fatalf("Reversed description should have %d vertices, got %v (%v)", len(forward), backward, err)
+	}
+	for i := range forward {
+		want := forward[len(forward)-1-i]
+		if math.Abs(backward[i].X-want.X) > 1e-9 || math.Abs(backward[i].Y-want.Y) > 1e-9 {
+			t.Errorf("Reversed vertex %d should be %v, got %v", i, want, backward[i])
+		}
+	}
+	area, _ := legal.Area(reversed.Metes)
+	if want := 10000.0 + math.Pi*50.0*50.0/2.0; math.Abs(area-want) > 1e-6 {
+		t.Errorf("Reversed parcel should keep its area of %v, got %v", want, area)
+	}
+	open := legal.Description{Metes: []legal.Mete{&north, &east}}
+	if _, err := open.Reverse(); err == nil {
+		t.Errorf("Reversing an unclosed boundary without coordinates should fail")
+	}
+}
+
+func TestDegreeOfCurve(t *testing.T) {
+	arc := legal.NewArcMeteFromDegreeOfCurve(1.0, math.Pi/2.0, 0.0, "feet", legal.Clockwise)
+	if result := arc.ArcLength(); math.Abs(result-9000.0) > 1e-6 {
+		t.Errorf("A 1° curve through 90° should be 9000 feet long, got %v", result)
+	}
+	if result := arc.DegreeOfCurve(); math.Abs(result-1.0) > 1e-12 {
+		t.Errorf("Degree of curve should round-trip, got %v", result)
+	}
+	if result := legal.ArcDefinition.Radius(1.0); math.Abs(result-5729.578) > 1e-3 {
+		t.Errorf("A 1° curve should have a radius of 5729.578 under the arc definition, got %v", result)
+	}
+	if result := legal.ChordDefinition.Radius(1.0); math.Abs(result-5729.651) > 1e-3 {
+		t.Errorf("A 1° curve should have a radius of 5729.651 under the chord definition, got %v", result)
+	}
+	if result := legal.ChordDefinition.Degree(legal.ChordDefinition.Radius(4.5)); math.Abs(result-4.5) > 1e-12 {
+		t.Errorf("Chord definition degree of curve should round-trip, got %v", result)
+	}
+}
+
+func TestSegments(t *testing.T) {
+	north := legal.NewLinearMete(0.0, 100.0, "feet")
+	arc := legal.NewArcMete(math.Pi, 50.0, math.Pi/2.0, "feet", legal.Clockwise)
+	west := legal.NewLinearMete(3.0*math.Pi/2.0, 100.0, "feet")
+	d := legal.Description{StartCoordinates: &[2]float64{1000.0, 2000.0}, Metes: []legal.Mete{&north, arc, &west}}
+	segments, err := d.Segments()
+	if err != nil {
+		t.Fatalf("Failed to break description into segments: %v", err)
+	}
+	if len(segments) != 3 {
+		t.Fatalf("Expected 3 segments, got %d", len(segments))
+	}
+	if segments[0].Preamble != "" || segments[0].Connector != "THENCE" || segments[0].Body != north.Describe() {
+		t.Errorf("First segment described incorrectly: %+v", segments[0])
+	}
+	if !strings.HasPrefix(segments[1].Preamble, "THE BEGINNING OF A NON-TANGENT CURVE") || segments[1].Bearing.Describe() != "DUE SOUTH" || math.Abs(segments[1].Distance-50.0*math.Pi) > 1e-9 {
+		t.Errorf("Curve segment described incorrectly: %+v", segments[1])
+	}
+	ends := []legal.Point{{X: 1000.0, Y: 2100.0}, {X: 1000.0, Y: 2000.0}, {X: 900.0, Y: 2000.0}}
+	for i, want := range ends {
+		if got := segments[i].End; math.Abs(got.X-want.X) > 1e-9 || math.Abs(got.Y-want.Y) > 1e-9 {
+			t.Errorf("Segment %d should end at %v, got %v", i+1, want, got)
+		}
+	}
+}
+
+func TestOptionalLocality(t *testing.T) {
+	var mete1, mete2 legal.LinearMete
+	mete1.FromString(`THENCE (1) North 2°02'36" East, 99.88 feet`)
+	mete2.FromString(`THENCE (2) South 2°02'36" West, 99.88 feet`)
+	d := legal.Description{
+		Kind:        "Drainage Easement",
+		Lot:         "11",
+		Subdivision: "Witt's Addition",
+		City:        "Conway",
+		Start:       legal.NorthWest,
+		Metes:       []legal.Mete{&mete1, &mete2},
+	}
+	result, err := d.Describe()
+	want := "A PART OF LOT 11, Witt's Addition TO THE CITY OF Conway, BEING MORE PARTICULARLY DESCRIBED AS FOLLOWS:"
+	if err != nil || !strings.Contains(result, want) {
+		t.Errorf("Missing county and state should be omitted\nexpected: %s\nerror: %v\nresult:\n%s", want, err, result)
+	}
+	parsed, err := legal.ParseDescription(result)
+	if err != nil || parsed.City != "Conway" || parsed.County != "" || parsed.State != "" {
+		t.Errorf("Description without county or state should parse back, got %+v (%v)", parsed, err)
+	}
+	d.County, d.State = "Faulkner", "Arkansas"
+	result, _ = d.Describe()
+	if want := "TO THE CITY OF Conway, Faulkner COUNTY, Arkansas, BEING"; !strings.Contains(result, want) {
+		t.Errorf("County and state should be stated\nexpected: %s\nresult:\n%s", want, result)
+	}
+}
+
+func TestPublicLandSurvey(t *testing.T) {
+	var mete1, mete2 legal.LinearMete
+	mete1.FromString(`THENCE (1) North 2°02'36" East, 99.88 feet`)
+	mete2.FromString(`THENCE (2) South 2°02'36" West, 99.88 feet`)
+	d := legal.Description{
+		Kind:        "Tract",
+		Section:     "12",
+		Township:    "2 NORTH",
+		Range:       "11 WEST",
+		Meridian:    "FIFTH PRINCIPAL MERIDIAN",
+		AliquotPart: "NE 1/4",
+		County:      "PULASKI",
+		State:       "ARKANSAS",
+		Start:       legal.NorthEast,
+		Metes:       []legal.Mete{&mete1, &mete2},
+	}
+	result, err := d.Describe()
+	want := "A PART OF THE NE 1/4 OF SECTION 12, TOWNSHIP 2 NORTH, RANGE 11 WEST OF THE FIFTH PRINCIPAL MERIDIAN, PULASKI COUNTY, ARKANSAS, BEING MORE PARTICULARLY DESCRIBED AS FOLLOWS:\nBEGINNING AT THE NORTHEAST CORNER OF SAID NE 1/4;"
+	if err != nil || !strings.Contains(result, want) {
+		t.Errorf("Section, township and range should be stated\nexpected: %s\nerror: %v\nresult:\n%s", want, err, result)
+	}
+	d.AliquotPart = ""
+	result, _ = d.Describe()
+	if want := "A PART OF SECTION 12, TOWNSHIP 2 NORTH"; !strings.Contains(result, want) || !strings.Contains(result, "CORNER OF SAID SECTION 12;") {
+		t.Errorf("A whole section should be stated without an aliquot part\nresult:\n%s", result)
+	}
+}
+
+func TestExceptions(t *testing.T) {
+	square := func(side float64) []legal.Mete {
+		var metes []legal.Mete
+		for _, angle := range []float64{0.0, math.Pi / 2.0, math.Pi, 3.0 * math.Pi / 2.0} {
+			mete := legal.NewLinearMete(angle, side, "feet")
+			metes = append(metes, &mete)
+		}
+		return metes
+	}
+	road := &legal.Description{PreTied: true, Metes: square(10.0), Area: 100.0, Unit: "square feet"}
+	d := legal.Description{
+		Kind:        "Tract",
+		Lot:         "11",
+		Subdivision: "Witt's Addition",
+		County:      "Pulaski",
+		State:       "Arkansas",
+		Start:       legal.SouthWest,
+		Metes:       square(100.0),
+		Area:        10000.0,
+		Unit:        "square feet",
+		Exceptions:  []*legal.Description{road},
+	}
+	result, err := d.Describe()
+	want := "CONTAINING 10000 square feet MORE OR LESS. LESS AND EXCEPT THE FOLLOWING DESCRIBED TRACT: BEGINNING AT THE POINT OF BEGINNING; THENCE DUE NORTH A DISTANCE OF 10.00 FEET"
+	if err != nil || !strings.Contains(result, want) || !strings.HasSuffix(result, "CONTAINING 100 square feet MORE OR LESS.") {
+		t.Errorf("Exception should follow the parcel\nexpected: %s\nerror: %v\nresult:\n%s", want, err, result)
+	}
+	d.DeductExceptions = true
+	result, err = d.Describe()
+	if err != nil || !strings.Contains(result, "CONTAINING 9900 square feet MORE OR LESS. LESS AND EXCEPT") {
+		t.Errorf("Exception area should be deducted from the parcel\nerror: %v\nresult:\n%s", err, result)
+	}
+	d.Area, d.Unit = 0.5, "acres"
+	result, err = d.Describe()
+	if err != nil || !strings.Contains(result, "CONTAINING 0.49770") {
+		t.Errorf("Exception area should be converted before it is deducted\nerror: %v\nresult:\n%s", err, result)
+	}
+	road.Format.Precision = &legal.Precision{Distance: 0, Area: 0, Seconds: 0}
+	d.Format.Precision = &legal.Precision{Distance: 3, Area: 2, Seconds: 2}
+	result, err = d.Describe()
+	if err != nil || !strings.Contains(result, "THENCE DUE NORTH A DISTANCE OF 10.000 FEET") {
+		t.Errorf("Exception should be written in the format of the parcel\nerror: %v\nresult:\n%s", err, result)
+	}
+}
+
+func TestPrecision(t *testing.T) {
+	b, _ := legal.NewBearing(legal.North, legal.East, 30, 15, 29.6)
+	mete := legal.NewLinearMete(b.ToAngle(), 123.456, "feet")
+	d := legal.Description{
+		Kind:        "Tract",
+		Lot:         "11",
+		Subdivision: "Witt's Addition",
+		County:      "Pulaski",
+		State:       "Arkansas",
+		Start:       legal.SouthWest,
+		Metes:       []legal.Mete{&mete},
+		Area:        1234.5678,
+		Unit:        "square feet",
+	}
+	cases := []struct {
+		precision  *legal.Precision
+		call, area string
+	}{
+		{nil, `THENCE NORTH 30°15'29.60" EAST A DISTANCE OF 123.46 FEET`, "CONTAINING 1234.5678 square feet"},
+		{&legal.Precision{Distance: 3, Area: 1, Seconds: 0}, `THENCE NORTH 30°15'30" EAST A DISTANCE OF 123.456 FEET`, "CONTAINING 1234.6 square feet"},
+	}
+	for _, c := range cases {
+		d.Format.Precision = c.precision
+		result, err := d.Describe()
+		if err != nil || !strings.Contains(result, c.call) || !strings.Contains(result, c.area) {
+			t.Errorf("Description at precision %+v should contain\n%s\n%s\nerror: %v\nresult:\n%s", c.precision, c.call, c.area, err, result)
+		}
+	}
+}
+
+func TestBearingRanges(t *testing.T) {
+	cases := []struct {
+		deg, min int
+		sec      float64
+		valid    bool
+	}{
+		{0, 0, 0.0, true},
+		{89, 59, 59.99, true},
+		{90, 0, 0.0, true},
+		{90, 0, 0.01, false},
+		{90, 1, 0.0, false},
+		{91, 0, 0.0, false},
+		{45, 59, 0.0, true},
+		{45, 60, 0.0, false},
+		{45, 0, 60.0, false},
+		{-1, 0, 0.0, false},
+		{45, -1, 0.0, false},
+		{45, 0, -0.01, false},
+	}
+	for _, c := range cases {
+		_, err := legal.NewBearing(legal.North, legal.East, c.deg, c.min, c.sec)
+		if (err == nil) != c.valid {
+			t.Errorf("%d°%d'%.2f\" should be valid: %v, error: %v", c.deg, c.min, c.sec, c.valid, err)
+		}
+	}
+	if d, m, s := legal.NormalizeDMS(29, 59, 60.0); d != 30 || m != 0 || s != 0.0 {
+		t.Errorf("29°59'60\" should normalize to 30°0'0\", got %d°%d'%f\"", d, m, s)
+	}
+	if d, m, s := legal.NormalizeDMS(10, 125, 90.5); d != 12 || m != 6 || s != 30.5 {
+		t.Errorf("10°125'90.5\" should normalize to 12°6'30.5\", got %d°%d'%f\"", d, m, s)
+	}
+}
+
+func TestBearingFromReportStrings(t *testing.T) {
+	cases := []struct {
+		text               string
+		primary, secondary legal.Direction
+		deg, min           int
+		sec                float64
+	}{
+		{`N 5°2'6" E`, legal.North, legal.East, 5, 2, 6.0},
+		{`S 88°21'22.1" E`, legal.South, legal.East, 88, 21, 22.1},
+		{`N 5°30.5' W`, legal.North, legal.West, 5, 30, 30.0},
+		{`N 5°02.5' E`, legal.North, legal.East, 5, 2, 30.0},
+	}
+	for _, c := range cases {
+		want, _ := legal.NewBearing(c.primary, c.secondary, c.deg, c.min, c.sec)
+		var result legal.Bearing
+		err := result.FromString(c.text)
+		if err != nil || math.Abs(result.ToAngle()-want.ToAngle()) > 1e-9 {
+			t.Errorf("%s should parse as %s, got %s with error %v", c.text, want.Describe(), result.Describe(), err)
+		}
+	}
+	for _, garbage := range []string{"", "NORTHEAST", `N 5°75' E`, `N 91°0'0" E`, `S 10°20'61" W`, `Q 5°2'6" E`} {
+		var result legal.Bearing
+		if err := result.FromString(garbage); err == nil {
+			t.Errorf("%q should not parse as a bearing, got %s", garbage, result.Describe())
+		}
+	}
+}
+
+func TestParseReportFixture(t *testing.T) {
+	report := `[INSERT PREAMBLE/CAPTION]:
+
+THENCE (1) South 2°02'36" West, 99.85 feet;
+
+THENCE (2) North 87°30'54" West, 5.00 feet;
+
+THENCE (3) North 2°02'36" East, 99.85 feet;
+
+THENCE (4) South 87°30'54" East, 5.00 feet;
+
+Containing 499.25 square feet, more or less.`
+	metes, area, unit, err := legal.ParseReport(report)
+	if err != nil {
+		t.Fatalf("ParseReport failed on the fixture: %v", err)
+	}
+	want := []string{
+		`SOUTH 2°2'36.00" WEST A DISTANCE OF 99.85 FEET`,
+		`NORTH 87°30'54.00" WEST A DISTANCE OF 5.00 FEET`,
+		`NORTH 2°2'36.00" EAST A DISTANCE OF 99.85 FEET`,
+		`SOUTH 87°30'54.00" EAST A DISTANCE OF 5.00 FEET`,
+	}
+	if len(metes) != len(want) {
+		t.Fatalf("ParseReport returned %d metes, expected %d", len(metes), len(want))
+	}
+	for i, m := range metes {
+		if result := m.Describe(); result != want[i] {
+			t.Errorf("Call %d should read %s, got %s", i+1, want[i], result)
+		}
+	}
+	if area != 499.25 || unit != "SQUARE FEET" {
+		t.Errorf("ParseReport should return an area of 499.25 square feet, got %v %q", area, unit)
+	}
+}
+
+func TestWKT(t *testing.T) {
+	north := legal.NewLinearMete(0.0, 10.0, "feet")
+	east := legal.NewLinearMete(math.Pi/2.0, 10.0, "feet")
+	south := legal.NewLinearMete(math.Pi, 10.0, "feet")
+	west := legal.NewLinearMete(3.0*math.Pi/2.0, 10.0, "feet")
+	d := legal.Description{PreTied: true, Metes: []legal.Mete{&north, &east, &south, &west}}
+	result, err := d.WKT(legal.Point{X: 1000.0, Y: 2000.0})
+	want := "POLYGON((1000 2000, 1000 2010, 1010 2010, 1010 2000, 1000 2000))"
+	if err != nil || result != want {
+		t.Errorf("WKT of a square\nexpected: %s\nresult:   %s\nerror: %v", want, result, err)
+	}
+	arc := legal.NewArcMete(math.Pi, 10.0, 0.0, "feet", legal.Clockwise)
+	back := legal.NewLinearMete(3.0*math.Pi/2.0, 20.0, "feet")
+	d.Metes = []legal.Mete{arc, &back}
+	coarse, _ := d.WKTWith(legal.Point{}, 10.0)
+	fine, err := d.WKTWith(legal.Point{}, 1.0)
+	if err != nil || strings.Count(fine, ",") <= strings.Count(coarse, ",") {
+		t.Errorf("A shorter chord should densify the arc further\ncoarse: %s\nfine: %s\nerror: %v", coarse, fine, err)
+	}
+	if !strings.HasPrefix(fine, "POLYGON((0 0, ") || !strings.HasSuffix(fine, ", 0 0))") {
+		t.Errorf("WKT ring should begin and end at the origin: %s", fine)
+	}
+	if _, err := d.WKTWith(legal.Point{}, 0.0); err == nil {
+		t.Errorf("WKTWith should reject a chord length of zero")
+	}
+}
+
+func TestReverseCurve(t *testing.T) {
+	first := legal.NewArcMete(math.Pi/4.0, 50.0, 0.0, "FEET", legal.Clockwise)
+	second := first.ReverseCurve(math.Pi/4.0, 30.0)
+	third := second.CompoundCurve(math.Pi/8.0, 60.0)
+	if diff := math.Abs(second.Tangent() - math.Pi/4.0); diff > 1e-12 {
+		t.Errorf("Reverse curve should begin on the tangent at the end of the first curve, got %f", second.Tangent())
+	}
+	if diff := math.Abs(third.Tangent()); diff > 1e-12 {
+		t.Errorf("Compound curve should begin on the tangent at the end of the reverse curve, got %f", third.Tangent())
+	}
+	d := legal.Description{PreTied: true, Metes: []legal.Mete{first, second, third}}
+	want := "THE BEGINNING OF A REVERSE CURVE CONCAVE NORTHWESTERLY, SAID CURVE HAS A RADIUS OF 30.00 FEET"
+	if result := d.Preamble(1, 0.0); result != want {
+		t.Errorf("Reverse curve preamble\nexpected: %s\nresult:   %s", want, result)
+	}
+	want = "THE BEGINNING OF A COMPOUND CURVE CONCAVE WESTERLY, SAID CURVE HAS A RADIUS OF 60.00 FEET"
+	if result := d.Preamble(2, 0.0); result != want {
+		t.Errorf("Compound curve preamble\nexpected: %s\nresult:   %s", want, result)
+	}
+}
+
+func TestTangency(t *testing.T) {
+	arcSecond := math.Pi / 648000.0
+	line := legal.NewLinearMete(math.Pi/3.0, 10.0, "feet")
+	cases := []struct {
+		prevTan float64
+		line    string
+		arc     string
+	}{
+		{math.Pi / 3.0, "A POINT OF TANGENCY", "THE BEGINNING OF A CURVE"},
+		{math.Pi/3.0 + 1e-12, "A POINT OF TANGENCY", "THE BEGINNING OF A CURVE"},
+		{math.Pi/3.0 - 0.9*arcSecond, "A POINT OF TANGENCY", "THE BEGINNING OF A CURVE"},
+		{math.Pi/3.0 + 2.0*arcSecond, "A POINT OF NON-TANGENCY", "THE BEGINNING OF A NON-TANGENT CURVE"},
+		{math.Pi/3.0 + 2.0*math.Pi, "A POINT OF TANGENCY", "THE BEGINNING OF A CURVE"},
+	}
+	arc := legal.NewArcMete(math.Pi/2.0, 25.0, math.Pi/3.0, "feet", legal.Clockwise)
+	for _, c := range cases {
+		if result := line.Preamble(c.prevTan); result != c.line {
+			t.Errorf("Line after a tangent of %.12f should read %s, got %s", c.prevTan, c.line, result)
+		}
+		if result := arc.Preamble(c.prevTan); !strings.HasPrefix(result, c.arc+" CONCAVE") {
+			t.Errorf("Arc after a tangent of %.12f should read %s, got %s", c.prevTan, c.arc, result)
+		}
+	}
+	// a line leaving a curve along its end tangent is described as tangent in the full description
+	curve := legal.NewArcMete(math.Pi/6.0, 25.0, math.Pi/6.0, "feet", legal.Clockwise)
+	d := legal.Description{PreTied: true, Metes: []legal.Mete{curve, &line}}
+	result, _ := d.Describe()
+	if !strings.Contains(result, "ARC DISTANCE OF 13.09 feet TO A POINT OF TANGENCY") {
+		t.Errorf("Line leaving a curve on its tangent should be described as tangent\nresult:\n%s", result)
+	}
+}
+
+func TestParseLandXML(t *testing.T) {
+	doc := `<?xml version="1.0"?>
+<LandXML xmlns="http://www.landxml.org/schema/LandXML-1.2" version="1.2">
+	<Units><Imperial linearUnit="foot" areaUnit="squareFoot"/></Units>
+	<Parcels>
+		<Parcel name="Lot 1" area="5000">
+			<CoordGeom>
+				<Line><Start>2000 1000</Start><End>2100 1000</End></Line>
+				<Line dir="90" length="50"/>
+				<Curve rot="cw"><Start>2100 1050</Start><Center>2050 1050</Center><End>2000 1050</End></Curve>
+				<Line><Start>2000 1050</Start><End>2000 1000</End></Line>
+			</CoordGeom>
+		</Parcel>
+	</Parcels>
+</LandXML>`
+	d, err := legal.ParseLandXML(strings.NewReader(doc))
+	if err != nil {
+		t.Fatalf("ParseLandXML failed: %v", err)
+	}
+	if len(d.Metes) != 4 || d.Area != 5000.0 || d.Unit != "SQUARE FEET" {
+		t.Fatalf("ParseLandXML returned %d metes and area %v %s", len(d.Metes), d.Area, d.Unit)
+	}
+	if d.StartCoordinates == nil || *d.StartCoordinates != [2]float64{1000.0, 2000.0} {
+		t.Errorf("Description should begin at the start of the first line, got %v", d.StartCoordinates)
+	}
+	want := []string{
+		"DUE NORTH A DISTANCE OF 100.00 FEET",
+		"DUE EAST A DISTANCE OF 50.00 FEET",
+		"SOUTHERLY ALONG SAID CURVE THROUGH A CENTRAL ANGLE OF 180°0'0.00\" AN ARC DISTANCE OF 157.08 FEET",
+		"DUE WEST A DISTANCE OF 50.00 FEET",
+	}
+	for i, m := range d.Metes {
+		if result := m.Describe(); result != want[i] {
+			t.Errorf("Element %d should read %s, got %s", i+1, want[i], result)
+		}
+	}
+	if _, err := legal.ParseLandXML(strings.NewReader(`<LandXML><Parcels/></LandXML>`)); err == nil {
+		t.Errorf("ParseLandXML should fail without a parcel")
+	}
+}
+
+func TestLandXMLExport(t *testing.T) {
+	north := legal.NewLinearMete(0.0, 100.0, "feet")
+	east := legal.NewLinearMete(math.Pi/2.0, 50.0, "feet")
+	arc := legal.NewArcMete(math.Pi, 25.0, math.Pi/2.0, "feet", legal.Clockwise)
+	west := legal.NewLinearMete(3.0*math.Pi/2.0, 50.0, "feet")
+	d := legal.Description{Kind: "Lot 1", Metes: []legal.Mete{&north, &east, arc, &west}, Area: 5000.0, Unit: "square feet"}
+	data, err := d.LandXML(legal.Point{X: 1000.0, Y: 2000.0})
+	if err != nil {
+		t.Fatalf("LandXML export failed: %v", err)
+	}
+	for _, want := range []string{`<Parcel name="Lot 1" area="5000">`, `rot="cw"`, `radius="25.000000"`, `chordDir="180.000000"`, `<Center>2075.000000 1050.000000</Center>`} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("LandXML should contain %s\n%s", want, data)
+		}
+	}
+	parsed, err := legal.ParseLandXML(strings.NewReader(string(data)))
+	if err != nil {
+		t.Fatalf("Exported LandXML could not be read back: %v\n%s", err, data)
+	}
+	if len(parsed.Metes) != len(d.Metes) || parsed.Area != d.Area {
+		t.Fatalf("LandXML round trip returned %d metes and area %v", len(parsed.Metes), parsed.Area)
+	}
+	for i := range d.Metes {
+		if want, result := d.Metes[i].Describe(), parsed.Metes[i].Describe(); !strings.EqualFold(want, result) {
+			t.Errorf("Call %d should survive a LandXML round trip\nexpected: %s\nresult:   %s", i+1, want, result)
+		}
+	}
+}
+
+func TestParseError(t *testing.T) {
+	var b legal.Bearing
+	err := b.FromString("N 5°75' E")
+	var pe *legal.ParseError
+	if !errors.As(err, &pe) || pe.Field != "bearing" || pe.Token != "N 5°75' E" || pe.Line != 0 {
+		t.Errorf("Bearing should fail with a parse error of its bearing, got %#v", err)
+	}
+	var m legal.LinearMete
+	err = m.FromString(`THENCE (1) North 30°1'1" East, feet`)
+	if !errors.As(err, &pe) || pe.Field != "distance" {
+		t.Errorf("Mete without a distance should fail with a parse error of its distance, got %#v", err)
+	}
+	report := `Caption
+THENCE (1) North 30°01'01" East, 25.00 feet
+THENCE (2) North 95°00'00" East, 25.00 feet
+Containing 100 square feet`
+	_, _, _, err = legal.ParseReport(report)
+	if !errors.As(err, &pe) || pe.Line != 3 || pe.Field != "bearing" || pe.Token != `North 95°00'00" East` {
+		t.Errorf("Report should fail with a parse error of the bearing on line 3, got %#v", err)
+	}
+	if pe != nil && (pe.Unwrap() == nil || !strings.HasPrefix(pe.Error(), "line 3: invalid bearing")) {
+		t.Errorf("Parse error should state its line and field and wrap its cause, got %q", pe.Error())
+	}
+	_, _, _, err = legal.ParseReport("Caption\nContaining square feet")
+	if !errors.As(err, &pe) || pe.Line != 2 || pe.Field != "area" {
+		t.Errorf("Report should fail with a parse error of the area on line 2, got %#v", err)
+	}
+}
+
+func TestDeflection(t *testing.T) {
+	cases := []struct {
+		from, to   string
+		deflection float64
+		rotation   legal.Rotation
+	}{
+		{`N 10°00'00" E`, `N 40°00'00" E`, 30.0, legal.Clockwise},
+		{`N 40°00'00" E`, `N 10°00'00" E`, 30.0, legal.CounterClockwise},
+		{`N 10°00'00" W`, `N 20°00'00" E`, 30.0, legal.Clockwise},
+		{`S 87°30'54" E`, `N 2°02'36" E`, 90.0 + 26.0/60.0 + 30.0/3600.0, legal.CounterClockwise},
+		{`N 80°00'00" W`, `N 80°00'00" E`, 160.0, legal.Clockwise},
+		{`N 45°00'00" E`, `N 45°00'00" E`, 0.0, legal.Clockwise},
+	}
+	for _, c := range cases {
+		var from, to legal.Bearing
+		from.FromString(c.from)
+		to.FromString(c.to)
+		deflection, rotation := from.DeflectionTo(to)
+		if math.Abs(deflection*180.0/math.Pi-c.deflection) > 1e-9 || rotation != c.rotation {
+			t.Errorf("Deflection from %s to %s should be %v° turning %v, got %v° turning %v", c.from, c.to, c.deflection, c.rotation, deflection*180.0/math.Pi, rotation)
+		}
+	}
+}
+
+func TestInteriorAngles(t *testing.T) {
+	var north, east, south, west legal.LinearMete
+	north.FromString(`THENCE (1) North 2°02'36" East, 99.88 feet`)
+	east.FromString(`THENCE (2) South 89°42'36" East, 50.00 feet`)
+	south.FromString(`THENCE (3) South 2°02'36" West, 99.88 feet`)
+	west.FromString(`THENCE (4) South 87°30'54" West, 50.00 feet`)
+	d := legal.Description{
+		PreTied: true,
+		Metes:   []legal.Mete{&north, &east, &south, &west},
+		Format:  legal.FormatOptions{Courses: legal.InteriorAngles},
+	}
+	result, err := d.Describe()
+	for _, want := range []string{
+		`THENCE NORTH 2°2'36.00" EAST A DISTANCE OF 99.88 FEET`,
+		`THENCE TURNING AN INTERIOR ANGLE OF 91°45'12.00" TO THE RIGHT, A DISTANCE OF 50.00 FEET`,
+		`THENCE TURNING AN INTERIOR ANGLE OF 88°14'48.00" TO THE RIGHT, A DISTANCE OF 99.88 FEET`,
+		`THENCE TURNING AN INTERIOR ANGLE OF 94°31'42.00" TO THE RIGHT, A DISTANCE OF 50.00 FEET`,
+	} {
+		if err != nil || !strings.Contains(result, want) {
+			t.Errorf("Interior angle description should contain\n%s\nerror: %v\nresult:\n%s", want, err, result)
+		}
+	}
+	var back1, back2, back3, back4 legal.LinearMete
+	back1.FromString(`THENCE (1) North 87°30'54" East, 50.00 feet`)
+	back2.FromString(`THENCE (2) North 2°02'36" East, 99.88 feet`)
+	back3.FromString(`THENCE (3) North 89°42'36" West, 50.00 feet`)
+	back4.FromString(`THENCE (4) South 2°02'36" West, 99.88 feet`)
+	d.Metes = []legal.Mete{&back1, &back2, &back3, &back4}
+	result, _ = d.Describe()
+	if want := `THENCE TURNING AN INTERIOR ANGLE OF 94°31'42.00" TO THE LEFT, A DISTANCE OF 99.88 FEET`; !strings.Contains(result, want) {
+		t.Errorf("A counterclockwise traverse should turn to the left\nexpected: %s\nresult:\n%s", want, result)
+	}
+}
+
+func TestEndMonument(t *testing.T) {
+	var north, east, south legal.LinearMete
+	north.FromString(`THENCE (1) North 2°02'36" East, 99.88 feet to a found 1/2" iron pin;`)
+	east.FromString(`THENCE (2) South 87°57'24" East, 50.00 feet to a point of non-tangency;`)
+	south.FromString(`THENCE (3) South 2°02'36" West, 99.88 feet`)
+	if north.EndMonument != `A FOUND 1/2" IRON PIN` || east.EndMonument != "" {
+		t.Errorf("Monument should be read from the end of a call, got %q and %q", north.EndMonument, east.EndMonument)
+	}
+	arc := legal.NewArcMete(math.Pi/2.0, 25.0, north.Tangent(), "feet", legal.Clockwise)
+	south.EndMonument = "A SET 5/8\" REBAR WITH CAP"
+	d := legal.Description{PreTied: true, Metes: []legal.Mete{&north, &east, &south}}
+	result, err := d.Describe()
+	for _, want := range []string{
+		`A DISTANCE OF 99.88 FEET TO A FOUND 1/2" IRON PIN; THENCE SOUTH 87°57'24.00" EAST`,
+		`A DISTANCE OF 99.88 FEET TO A SET 5/8" REBAR WITH CAP, THE POINT OF BEGINNING, CONTAINING`,
+	} {
+		if err != nil || !strings.Contains(result, want) {
+			t.Errorf("Description should name the monument\nexpected: %s\nerror: %v\nresult:\n%s", want, err, result)
+		}
+	}
+	d.Metes = []legal.Mete{&north, arc}
+	if result := d.Preamble(1, north.Tangent()); result != `A FOUND 1/2" IRON PIN, SAID POINT BEING THE BEGINNING OF A CURVE CONCAVE SOUTHEASTERLY, SAID CURVE HAS A RADIUS OF 25.00 feet` {
+		t.Errorf("Monument at the beginning of a curve should precede the curve, got %s", result)
+	}
+}
+
+func TestDescriptionPerimeter(t *testing.T) {
+	north := legal.NewLinearMete(0.0, 100.0, "feet")
+	east := legal.NewLinearMete(math.Pi/2.0, 15.24, "meters")
+	south := legal.NewLinearMete(math.Pi, 100.0, "feet")
+	west := legal.NewLinearMete(3.0*math.Pi/2.0, 50.0, "feet")
+	d := legal.Description{
+		PreTied: true,
+		Metes:   []legal.Mete{&north, &east, &south, &west},
+		Area:    5000.0,
+		Unit:    "square feet",
+		Format:  legal.FormatOptions{StatePerimeter: true},
+	}
+	perimeter, unit, err := d.Perimeter()
+	if err != nil || math.Abs(perimeter-300.0) > 1e-9 || unit != "FEET" {
+		t.Errorf("Perimeter of a 100 x 50 rectangle should be the sum of its sides, 300 FEET, got %v %s and error %v", perimeter, unit, err)
+	}
+	result, err := d.Describe()
+	if want := "CONTAINING 5000 square feet MORE OR LESS. SAID TRACT HAVING A PERIMETER OF 300.00 FEET."; err != nil || !strings.Contains(result, want) {
+		t.Errorf("Description should state the perimeter\nexpected: %s\nerror: %v\nresult:\n%s", want, err, result)
+	}
+	d.Format.StatePerimeter = false
+	if result, _ = d.Describe(); strings.Contains(result, "PERIMETER") {
+		t.Errorf("Perimeter should only be stated when asked for\n%s", result)
+	}
+}
+
+func TestDecimalDegreeBearing(t *testing.T) {
+	cases := []struct {
+		dms, decimal string
+	}{
+		{"N12d34m53.76sE", "N12.5816E"},
+		{"S45d30m0sW", "S45.5W"},
+		{"N0d15m0sW", "N 0.25 W"},
+		{"S89d59m24sE", "S89.99E"},
+		{"N30d0m0sE", "N30E"},
+	}
+	for _, c := range cases {
+		var dms, decimal legal.Bearing
+		if err := dms.FromString(c.dms); err != nil {
+			t.Errorf("%s should parse: %v", c.dms, err)
+			continue
+		}
+		if err := decimal.FromString(c.decimal); err != nil {
+			t.Errorf("%s should parse: %v", c.decimal, err)
+			continue
+		}
+		if math.Abs(dms.ToAngle()-decimal.ToAngle()) > 1e-12 || dms.Describe() != decimal.Describe() {
+			t.Errorf("%s should be the same bearing as %s, got %s and %s", c.decimal, c.dms, decimal.Describe(), dms.Describe())
+		}
+	}
+	var b legal.Bearing
+	if err := b.FromString("N95.5E"); err == nil {
+		t.Errorf("Decimal bearing beyond a right angle should not parse, got %s", b.Describe())
+	}
+}
+
+func TestValidate(t *testing.T) {
+	line := legal.NewLinearMete(0.0, 100.0, "feet")
+	tangent := legal.NewArcMete(math.Pi/2.0, 25.0, 0.0, "feet", legal.Clockwise)
+	drifted := legal.NewArcMete(math.Pi/2.0, 25.0, 5.0*math.Pi/648000.0, "feet", legal.Clockwise)
+	nonTangent := legal.NewArcMete(math.Pi/2.0, 25.0, math.Pi/4.0, "feet", legal.Clockwise)
+	zero := legal.NewLinearMete(0.0, 0.0, "feet")
+	valid := func() legal.Description {
+		return legal.Description{Kind: "Drainage Easement", Start: legal.NorthEast, Metes: []legal.Mete{&line, tangent}, Area: 100.0, Strict: true}
+	}
+	d := valid()
+	if err := d.Validate(); err != nil {
+		t.Errorf("Valid description should pass validation, got %v", err)
+	}
+	d.Metes = []legal.Mete{&line, nonTangent}
+	if err := d.Validate(); err != nil {
+		t.Errorf("Deliberately non-tangent curve should pass validation, got %v", err)
+	}
+	cases := []struct {
+		name   string
+		modify func(d *legal.Description)
+	}{
+		{"no kind", func(d *legal.Description) { d.Kind = "" }},
+		{"no metes", func(d *legal.Description) { d.Metes = nil }},
+		{"invalid start", func(d *legal.Description) { d.Start = legal.Direction(9) }},
+		{"negative area", func(d *legal.Description) { d.Area = -1.0 }},
+		{"zero distance", func(d *legal.Description) { d.Metes = []legal.Mete{&zero} }},
+		{"drifted tangent", func(d *legal.Description) { d.Metes = []legal.Mete{&line, drifted} }},
+	}
+	for _, c := range cases {
+		d := valid()
+		c.modify(&d)
+		if err := d.Validate(); err == nil {
+			t.Errorf("Description with %s should fail validation", c.name)
+		}
+		if _, err := d.Describe(); err == nil {
+			t.Errorf("Strict description with %s should not be described", c.name)
+		}
+	}
+}
+
+func TestAreaIn(t *testing.T) {
+	d := legal.Description{Area: 10890, Unit: "square feet"}
+	if area, unit, err := d.AreaIn(legal.Acres); err != nil || math.Abs(area-0.25) > 1e-9 || unit != "ACRES" {
+		t.Errorf("10890 square feet should be 0.25 ACRES, got %v %s (%v)", area, unit, err)
+	}
+	if area, _, err := (&legal.Description{Area: 1.0, Unit: "SQUARE CHAINS"}).AreaIn(legal.Acres); err != nil || math.Abs(area-0.1) > 1e-6 {
+		t.Errorf("One square chain should be a tenth of an acre, to the difference between the survey and international foot, got %v (%v)", area, err)
+	}
+	if _, _, err := (&legal.Description{Area: 1.0, Unit: "ARPENTS"}).AreaIn(legal.Acres); err == nil {
+		t.Errorf("An area in an unrecognized unit should fail to convert")
+	}
+	u, ok := legal.AreaUnitFromString("Hectare")
+	if !ok || u != legal.Hectares {
+		t.Errorf("Hectare should be recognized as hectares, got %v", u)
+	}
+	if _, ok := legal.AreaUnitFromString("furlongs"); ok {
+		t.Errorf("Furlongs should not be recognized as a unit of area")
+	}
+	d.Format.AreaUnits = []string{legal.Acres.Describe(), legal.SquareFeet.Describe()}
+	want := "0.25 ACRES (10,890 SQUARE FEET)"
+	if result, _ := d.AreaText(); result != want {
+		t.Errorf("Area should be stated as %s, got %s", want, result)
+	}
+}
+
+func TestStartDescription(t *testing.T) {
+	d := legal.Description{Lot: "1", Subdivision: "Witt's Addition", Start: legal.NorthWest}
+	want := "BEGINNING AT THE NORTHWEST CORNER OF SAID LOT 1"
+	if result := d.Beginning(); result != want {
+		t.Errorf("Beginning should default to the corner %s, got %s", want, result)
+	}
+	d.StartDescription = "a point on the north line of said lot"
+	want = "BEGINNING AT A POINT ON THE NORTH LINE OF SAID LOT"
+	if result := d.Beginning(); result != want {
+		t.Errorf("Beginning should be %s, got %s", want, result)
+	}
+	d.Commencement = true
+	want = "COMMENCING AT A POINT ON THE NORTH LINE OF SAID LOT"
+	if result := d.Beginning(); result != want {
+		t.Errorf("Beginning should be %s, got %s", want, result)
+	}
+}
+
+func TestSixteenPoints(t *testing.T) {
+	names := []string{"NORTH-NORTHEAST", "EAST-NORTHEAST", "EAST-SOUTHEAST", "SOUTH-SOUTHEAST", "SOUTH-SOUTHWEST",
+		"WEST-SOUTHWEST", "WEST-NORTHWEST", "NORTH-NORTHWEST"}
+	for i, want := range names {
+		angle := float64(2*i+1) * math.Pi / 8.0
+		if result := legal.DirectionFromAngle16(angle).Describe(); result != want {
+			t.Errorf("%.1f degrees should be %s, got %s", angle*180.0/math.Pi, want, result)
+		}
+		if dir, ok := legal.DirectionFromString(want); !ok || dir.Describe() != want {
+			t.Errorf("%s should be recognized as a direction, got %v", want, dir)
+		}
+		if dir, ok := legal.CornerFromString(want); ok {
+			t.Errorf("%s should not be recognized as a corner, got %s", want, dir.Describe())
+		}
+	}
+	if dir, ok := legal.CornerFromString("nw"); !ok || dir != legal.NorthWest {
+		t.Errorf("NW should be recognized as the northwest corner, got %v", dir)
+	}
+	if result := legal.DirectionFromAngle16(math.Pi / 2.0); result != legal.East {
+		t.Errorf("90 degrees should be EAST on a sixteen point compass, got %s", result.Describe())
+	}
+	central := 10.0 * math.Pi / 180.0
+	tangent := -75.0 * math.Pi / 180.0 // concave 20 degrees east of north
+	arc := legal.NewArcMete(central, 100.0, tangent, "FEET", legal.Clockwise)
+	opts := legal.FormatOptions{SixteenPoints: true}
+	want := "THE BEGINNING OF A CURVE CONCAVE NORTH-NORTHEASTERLY, SAID CURVE HAS A RADIUS OF 100.00 FEET"
+	if result := arc.PreambleWith(tangent, opts); result != want {
+		t.Errorf("Preamble should be %s, got %s", want, result)
+	}
+	if result := arc.Preamble(tangent); !strings.HasPrefix(result, "THE BEGINNING OF A CURVE CONCAVE NORTHERLY,") {
+		t.Errorf("Preamble should be NORTHERLY on an eight point compass, got %s", result)
+	}
+	if result := arc.DescribeWith(opts); !strings.HasPrefix(result, "WEST-NORTHWESTERLY ALONG SAID CURVE") {
+		t.Errorf("Curve should run WEST-NORTHWESTERLY, got %s", result)
+	}
+}
+
+func TestWriteTo(t *testing.T) {
+	metes := make([]legal.Mete, 0, 400)
+	for i := 0; i < 100; i++ {
+		for _, call := range []string{`THENCE (1) North 0°00'00" East, 10.00 feet`, `THENCE (2) South 90°00'00" East, 10.00 feet`,
+			`THENCE (3) South 0°00'00" West, 10.00 feet`, `THENCE (4) North 90°00'00" West, 10.00 feet`} {
+			var m legal.LinearMete
+			m.FromString(call)
+			metes = append(metes, &m)
+		}
+	}
+	d := legal.Description{Kind: "TRACT", Start: legal.NorthWest, Area: 0.0, Unit: "SQUARE FEET", Metes: metes, ShowClosing: true}
+	want, err := d.Describe()
+	if err != nil {
+		t.Fatalf("Describe failed: %v", err)
+	}
+	var b bytes.Buffer
+	var w io.WriterTo = &d
+	n, err := w.WriteTo(&b)
+	if err != nil {
+		t.Fatalf("WriteTo failed: %v", err)
+	}
+	if b.String() != want || n != int64(len(want)) {
+		t.Errorf("WriteTo should write the description of %d bytes, wrote %d bytes:\n%s", len(want), n, b.String())
+	}
+	if strings.Count(want, ";") != len(metes) {
+		t.Errorf("Each call should follow a semicolon, got %d semicolons", strings.Count(want, ";"))
+	}
+}
+
+func TestDescriptionPunctuation(t *testing.T) {
+	calls := func(lines ...string) []legal.Mete {
+		metes := make([]legal.Mete, len(lines))
+		for i, line := range lines {
+			var m legal.LinearMete
+			m.FromString(line)
+			metes[i] = &m
+		}
+		return metes
+	}
+	exception := &legal.Description{Start: legal.SouthWest, Area: 100.0, Unit: "SQUARE FEET", Metes: calls(
+		`THENCE (1) North 0°00'00" East, 10.00 feet`,
+		`THENCE (2) South 90°00'00" East, 10.00 feet`,
+		`THENCE (3) South 0°00'00" West, 10.00 feet`,
+		`THENCE (4) North 90°00'00" West, 10.00 feet`)}
+	d := legal.Description{Kind: "TRACT", Start: legal.NorthWest, Area: 10000.0, Unit: "SQUARE FEET", Width: 100.0, Depth: 100.0,
+		Exceptions: []*legal.Description{exception}, Metes: calls(
+			`THENCE (1) South 90°00'00" East, 100.00 feet`,
+			`THENCE (2) South 0°00'00" West, 100.00 feet`,
+			`THENCE (3) North 90°00'00" West, 100.00 feet`,
+			`THENCE (4) North 0°00'00" East, 100.00 feet`)}
+	legal, err := d.Describe()
+	if err != nil {
+		t.Fatalf("Describe failed: %v", err)
+	}
+	tracts := strings.Split(legal, " LESS AND EXCEPT THE FOLLOWING DESCRIBED TRACT: ")
+	if len(tracts) != 2 {
+		t.Fatalf("Description should have one exception, got %s", legal)
+	}
+	for i, tract := range tracts {
+		if n := strings.Count(tract, "; THENCE"); n != 4 {
+			t.Errorf("Each of the 4 calls of tract %d should follow a semicolon, got %d in %s", i, n, tract)
+		}
+		if strings.Contains(tract, "FEET THENCE") || strings.Contains(tract, ";;") {
+			t.Errorf("Calls of tract %d should be separated by single semicolons, got %s", i, tract)
+		}
+	}
+	if !strings.Contains(tracts[0], " TO THE POINT OF BEGINNING, CONTAINING 10000 SQUARE FEET MORE OR LESS.") {
+		t.Errorf("Parcel should end at the point of beginning with its area, got %s", tracts[0])
+	}
+	if !strings.HasSuffix(tracts[1], " TO THE POINT OF BEGINNING, CONTAINING 100 SQUARE FEET MORE OR LESS.") {
+		t.Errorf("Exception should end at the point of beginning with its area, got %s", tracts[1])
+	}
+}
+
+func TestToReport(t *testing.T) {
+	source, err := ioutil.ReadFile("../example.txt")
+	if err != nil {
+		t.Fatalf("Failed to read the example report: %v", err)
+	}
+	metes, area, unit, err := legal.ParseReport(string(source))
+	if err != nil {
+		t.Fatalf("ParseReport failed on the example: %v", err)
+	}
+	d := legal.Description{Area: area, Unit: unit, Metes: metes}
+	report := d.ToReport()
+	again, againArea, againUnit, err := legal.ParseReport(report)
+	if err != nil {
+		t.Fatalf("ParseReport failed on its own report: %v\n%s", err, report)
+	}
+	if len(again) != len(metes) || againArea != area || againUnit != unit {
+		t.Fatalf("Report should have %d metes and %v %s, got %d metes and %v %s\n%s", len(metes), area, unit, len(again), againArea, againUnit, report)
+	}
+	for i := range metes {
+		if want, result := metes[i].Describe(), again[i].Describe(); want != result {
+			t.Errorf("Mete %d should be read back as %s, got %s", i+1, want, result)
+		}
+	}
+	d.Metes = again
+	if result := d.ToReport(); result != report {
+		t.Errorf("Report should be stable\nexpected:%s\nresult:%s", report, result)
+	}
+}
+
+func TestArcFromChord(t *testing.T) {
+	for _, rot := range []legal.Rotation{legal.Clockwise, legal.CounterClockwise} {
+		known := legal.NewArcMete(1.2, 50.0, 0.3, "FEET", rot)
+		arc, err := legal.NewArcMeteFromChord(known.ChordLength(), known.ChordAngle(), 50.0, "FEET", rot)
+		if err != nil {
+			t.Fatalf("Failed to create an arc from its chord: %v", err)
+		}
+		if math.Abs(arc.Tangent()-known.Tangent()) > 1e-9 || math.Abs(arc.ArcLength()-known.ArcLength()) > 1e-9 {
+			t.Errorf("Arc from chord should have tangent %v and length %v, got %v and %v", known.Tangent(), known.ArcLength(), arc.Tangent(), arc.ArcLength())
+		}
+		if want, result := known.Describe(), arc.Describe(); want != result {
+			t.Errorf("Arc from chord should be described as %s, got %s", want, result)
+		}
+	}
+	if _, err := legal.NewArcMeteFromChord(100.01, 0.0, 50.0, "FEET", legal.Clockwise); err == nil {
+		t.Errorf("A chord longer than the diameter should be rejected")
+	}
+}
+
+func TestDescriptionFromPoints(t *testing.T) {
+	pts := []legal.Point{{X: 1000.0, Y: 5000.0}, {X: 1100.0, Y: 5000.0}, {X: 1100.0, Y: 4950.0}, {X: 1000.0, Y: 4950.0}}
+	d, err := legal.DescriptionFromPoints(pts, "feet")
+	if err != nil {
+		t.Fatalf("Failed to derive a description from points: %v", err)
+	}
+	if len(d.Metes) != 4 || math.Abs(d.Area-5000.0) > 1e-6 || d.Unit != "SQUARE FEET" {
+		t.Fatalf("Description should have 4 courses containing 5000 SQUARE FEET, got %d courses containing %v %s", len(d.Metes), d.Area, d.Unit)
+	}
+	want := []string{"DUE EAST A DISTANCE OF 100.00 FEET", "DUE SOUTH A DISTANCE OF 50.00 FEET", "DUE WEST A DISTANCE OF 100.00 FEET", "DUE NORTH A DISTANCE OF 50.00 FEET"}
+	for i, m := range d.Metes {
+		if result := m.Describe(); result != want[i] {
+			t.Errorf("Course %d should be %s, got %s", i+1, want[i], result)
+		}
+	}
+	if _, _, linear, _, err := d.Closure(); err != nil || linear > 1e-9 {
+		t.Errorf("Description from points should close, got misclosure %v %v", linear, err)
+	}
+	closed, err := legal.DescriptionFromPoints(append(pts, pts[0]), "feet")
+	if err != nil || len(closed.Metes) != 4 {
+		t.Errorf("A closed list of points should not repeat the closing course, got %v", err)
+	}
+	if _, err := legal.DescriptionFromPoints(pts[:2], "feet"); err == nil {
+		t.Errorf("Two points should not make a parcel")
+	}
+}
+
+func TestPointFile(t *testing.T) {
+	file, err := os.Open("../example.csv")
+	if err != nil {
+		t.Fatalf("Failed to open the example point file: %v", err)
+	}
+	defer file.Close()
+	points, err := legal.ParsePoints(file)
+	if err != nil {
+		t.Fatalf("ParsePoints failed on the example: %v", err)
+	}
+	if len(points) != 5 || points[0].Name != "1" || points[0].Description != "FIP 1/2 IRON PIN" || points[0].X != 1000.0 || points[0].Y != 5000.0 {
+		t.Fatalf("Example should have 5 points beginning with 1 at N 5000 E 1000, got %v", points)
+	}
+	ordered, err := legal.OrderPoints(points, []string{"1", "2", "3", "4"})
+	if err != nil {
+		t.Fatalf("OrderPoints failed: %v", err)
+	}
+	d, err := legal.DescriptionFromPoints(ordered, "feet")
+	if err != nil {
+		t.Fatalf("Failed to derive a description from the example: %v", err)
+	}
+	d.Kind = "TEST"
+	d.Format.Precision = &legal.Precision{Distance: 2, Area: 2, Seconds: 2}
+	result, err := d.Describe()
+	if err != nil {
+		t.Fatalf("Describe failed: %v", err)
+	}
+	for _, want := range []string{"BEGINNING AT A POINT HAVING COORDINATES N 5000.00 E 1000.00; THENCE NORTH 2°2'30.94\" EAST A DISTANCE OF 99.91 FEET",
+		"TO THE POINT OF BEGINNING, CONTAINING 9987.17 SQUARE FEET MORE OR LESS."} {
+		if !strings.Contains(result, want) {
+			t.Errorf("Description of the example should contain %s, got %s", want, result)
+		}
+	}
+	if _, err := legal.OrderPoints(points, []string{"1", "2", "5"}); err == nil {
+		t.Errorf("Ordering by a missing point should fail")
+	}
+	_, err = legal.ParsePoints(strings.NewReader("1,5000.00,1000.00\n2,north,1000.00\n"))
+	var pe *legal.ParseError
+	if !errors.As(err, &pe) || pe.Line != 2 || pe.Field != "northing" {
+		t.Errorf("An invalid northing should be a parse error on line 2, got %v", err)
+	}
+}
+
+func TestPointFileCommand(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds the command")
+	}
+	gobin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("no go command to build the command with")
+	}
+	out, err := exec.Command(gobin, "run", "../cmd/legal", "-kind", "test", "-points", "../example.csv", "-order", "1,2,3,4",
+		"-punit", "feet", "-cdir", "N0d0m0sE", "-cdist", "50").CombinedOutput()
+	if err != nil {
+		t.Fatalf("legal -points failed: %v\n%s", err, out)
+	}
+	result := string(out)
+	for _, want := range []string{"COMMENCING AT A POINT HAVING COORDINATES N 4950.00 E 1000.00; THENCE DUE NORTH A DISTANCE OF 50.00 FEET TO THE POINT OF BEGINNING; THENCE NORTH 2°2'30.94\" EAST A DISTANCE OF 99.91 FEET",
+		"TO THE POINT OF BEGINNING, CONTAINING 9987.17 SQUARE FEET MORE OR LESS."} {
+		if !strings.Contains(result, want) {
+			t.Errorf("Description of the example point file should contain %s, got %s", want, result)
+		}
+	}
+	if strings.Count(result, "POINT OF BEGINNING") != 2 {
+		t.Errorf("The tie and the boundary should each end at the point of beginning, got %s", result)
+	}
+	out, _ = exec.Command(gobin, "run", "../cmd/legal", "-kind", "test", "-points", "../example.csv", "-order", "1,2,9").CombinedOutput()
+	if !strings.Contains(string(out), `no point named "9"`) {
+		t.Errorf("Ordering by a missing point should be reported, got %s", out)
+	}
+	config, err := ioutil.TempFile("", "legal*.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(config.Name())
+	config.WriteString(`{"kind": "test", "order": "1,2,3,4", "punit": "feet", "perimeter": true, "case": "title"}`)
+	config.Close()
+	out, err = exec.Command(gobin, "run", "../cmd/legal", "-config", config.Name(), "-points", "../example.csv", "-case", "upper").CombinedOutput()
+	if err != nil || !strings.Contains(string(out), "SAID TRACT HAVING A PERIMETER OF") {
+		t.Errorf("Settings of a config file should apply beneath the flags given, got %v\n%s", err, out)
+	}
+	out, err = exec.Command(gobin, "run", "../cmd/legal", "-out", os.DevNull, "../example.txt", "../example.txt").CombinedOutput()
+	if err == nil || !strings.Contains(string(out), "-out can not be used with more than one report") {
+		t.Errorf("Writing several reports to one file should be refused, got %v\n%s", err, out)
+	}
+}
+
+func TestGon(t *testing.T) {
+	for _, gon := range []float64{0.0, 0.0001, 99.9999, 100.0, 100.0001, 199.9999, 200.0, 200.0001, 299.9999, 300.0, 300.0001, 399.9999} {
+		var b legal.Bearing
+		b.FromGon(gon)
+		if result := b.Gon(); math.Abs(result-gon) > 1e-9 {
+			t.Errorf("%v gon should round trip, got %v", gon, result)
+		}
+		var again legal.Bearing
+		again.FromAngle(b.ToAngle())
+		if result := again.Gon(); math.Abs(result-gon) > 1e-9 {
+			t.Errorf("%v gon should round trip through radians, got %v", gon, result)
+		}
+	}
+	cases := map[float64]string{
+		50.0:  `NORTH 45°0'0.00" EAST`,
+		100.0: "DUE EAST",
+		150.0: `SOUTH 45°0'0.00" EAST`,
+		250.0: `SOUTH 45°0'0.00" WEST`,
+		350.0: `NORTH 45°0'0.00" WEST`,
+		400.0: "DUE NORTH",
+	}
+	for gon, want := range cases {
+		var b legal.Bearing
+		b.FromGon(gon)
+		if result := b.Describe(); result != want {
+			t.Errorf("%v gon should be %s, got %s", gon, want, result)
+		}
+	}
+}
+
+func TestCalculatedAreaNote(t *testing.T) {
+	pts := []legal.Point{{X: 0.0, Y: 0.0}, {X: 0.0, Y: 50.0}, {X: 100.0, Y: 50.0}, {X: 100.0, Y: 0.0}}
+	d, err := legal.DescriptionFromPoints(pts, "feet")
+	if err != nil {
+		t.Fatalf("Failed to derive a description from points: %v", err)
+	}
+	d.Area = 5100.0
+	if note := d.CalculatedAreaNote(); note != "" {
+		t.Errorf("Calculated area should not be stated without a tolerance, got %s", note)
+	}
+	d.Format.CalculatedAreaTolerance = 10.0
+	want := "MORE OR LESS (CALCULATED AREA: 5000.00 SQUARE FEET)."
+	if result, err := d.Describe(); err != nil || !strings.Contains(result, want) {
+		t.Errorf("Description should state %s, got %s %v", want, result, err)
+	}
+	d.Area = 5005.0
+	if note := d.CalculatedAreaNote(); note != "" {
+		t.Errorf("Calculated area within tolerance should not be stated, got %s", note)
+	}
+	d.Area, d.Unit = 0.5, "ACRES"
+	d.Format.CalculatedAreaTolerance = 0.01
+	if note := d.CalculatedAreaNote(); note != "(CALCULATED AREA: 0.11 ACRES)" {
+		t.Errorf("Calculated area should be stated in acres, got %s", note)
+	}
+	calculated, _ := d.CalculatedArea()
+	if computed, _, _, err := d.AreaCheck(0.0); err != nil || computed != calculated {
+		t.Errorf("The calculated area should be that of the area check, got %v and %v (%v)", calculated, computed, err)
+	}
+}
+
+// exampleDescription is the description of the example report
+func exampleDescription(tb testing.TB) *legal.Description {
+	report, err := ioutil.ReadFile("../example.txt")
+	if err != nil {
+		tb.Fatalf("Failed to read the example report: %v", err)
+	}
+	metes, area, unit, err := legal.ParseReport(string(report))
+	if err != nil {
+		tb.Fatalf("ParseReport failed on the example: %v", err)
+	}
+	return &legal.Description{Kind: "EXAMPLE", Lot: "1", Subdivision: "EXAMPLE ADDITION", Start: legal.SouthWest, Area: area, Unit: unit, Metes: metes}
+}
+
+func TestDescribeConcurrent(t *testing.T) {
+	d := exampleDescription(t)
+	want, err := d.Describe()
+	if err != nil {
+		t.Fatalf("Describe failed: %v", err)
+	}
+	results := make(chan string, 8)
+	for i := 0; i < cap(results); i++ {
+		go func() {
+			result, _ := d.Describe()
+			results <- result
+		}()
+	}
+	for i := 0; i < cap(results); i++ {
+		if result := <-results; result != want {
+			t.Errorf("Concurrent descriptions should match\nexpected:%s\nresult:%s", want, result)
+		}
+	}
+}
+
+func BenchmarkDescribe(b *testing.B) {
+	d := exampleDescription(b)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := d.Describe(); err != nil {
+			b.Fatalf("Describe failed: %v", err)
+		}
+	}
+}
+
+func BenchmarkDescribeParallel(b *testing.B) {
+	d := exampleDescription(b)
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if _, err := d.Describe(); err != nil {
+				b.Errorf("Describe failed: %v", err)
+				return
+			}
+		}
+	})
+}
+
+func TestCustomTemplate(t *testing.T) {
+	d := exampleDescription(t)
+	standard, err := d.Describe()
+	if err != nil {
+		t.Fatalf("Describe failed: %v", err)
+	}
+	reworded := template.Must(template.New("county").Funcs(legal.TemplateFuncs()).Parse(strings.Replace(legal.DefaultTemplate, "MORE OR LESS", "PLUS OR MINUS", 1)))
+	result, err := d.DescribeWith(reworded)
+	if err != nil {
+		t.Fatalf("DescribeWith failed: %v", err)
+	}
+	if want := strings.Replace(standard, "MORE OR LESS", "PLUS OR MINUS", 1); result != want {
+		t.Errorf("Reworded description should be\n%s\ngot\n%s", want, result)
+	}
+	calls := template.Must(template.New("calls").Funcs(legal.TemplateFuncs()).Parse(
+		`{{range $i, $m := .Metes}}{{if $i}} / {{Preamble $m (Tangent $m)}}: {{end}}{{Describe $m}}{{end}}`))
+	result, err = d.DescribeWith(calls)
+	if err != nil {
+		t.Fatalf("DescribeWith failed: %v", err)
+	}
+	if parts := strings.Split(result, " / "); len(parts) != len(d.Metes) || parts[0] != d.Metes[0].Describe() {
+		t.Errorf("Template functions should describe each of %d metes, got %s", len(d.Metes), result)
+	}
+}
+
+func TestCase(t *testing.T) {
+	d := exampleDescription(t)
+	d.Lot, d.Block, d.Subdivision, d.City, d.County, d.State = "11", "15", "WITT'S ADDITION", "NORTH LITTLE ROCK", "PULASKI", "ARKANSAS"
+	upper, err := d.Describe()
+	if err != nil {
+		t.Fatalf("Describe failed: %v", err)
+	}
+	cases := []struct {
+		letterCase legal.Case
+		want       []string
+	}{
+		{legal.UpperCase, []string{"A PART OF LOT 11, BLOCK 15, WITT'S ADDITION TO THE CITY OF NORTH LITTLE ROCK, PULASKI COUNTY, ARKANSAS"}},
+		{legal.TitleCase, []string{
+			"A Part of Lot 11, Block 15, Witt's Addition to the City of North Little Rock, Pulaski County, Arkansas, Being More Particularly Described as Follows:",
+			"Beginning at the Southwest Corner of Said Lot 11; Thence South 2°2'36.00\" West a Distance of 99.85 Feet to the Beginning of a Curve Concave Northwesterly",
+		}},
+		{legal.SentenceCase, []string{
+			"Example description:",
+			"A part of Lot 11, Block 15, Witt's Addition to the City of North Little Rock, Pulaski County, Arkansas, being more particularly described as follows:",
+			"Beginning at the southwest corner of said Lot 11; thence South 2°2'36.00\" West a distance of 99.85 feet to the beginning of a curve concave northwesterly",
+			"to which a radial line bears South 2°29'6.00\" West;",
+			"to the point of beginning, containing 637.44 square feet more or less.",
+		}},
+	}
+	for _, c := range cases {
+		d.Format.Case = c.letterCase
+		result, err := d.Describe()
+		if err != nil {
+			t.Fatalf("Describe failed in case %d: %v", c.letterCase, err)
+		}
+		if strings.ToUpper(result) != strings.ToUpper(upper) {
+			t.Errorf("Case %d should only change capitalization, got %s", c.letterCase, result)
+		}
+		for _, want := range c.want {
+			if !strings.Contains(result, want) {
+				t.Errorf("Case %d should contain %s, got %s", c.letterCase, want, result)
+			}
+		}
+	}
+	d.Lot = "A"
+	d.Format.Case = legal.TitleCase
+	if result, _ := d.Describe(); !strings.Contains(result, "A Part of Lot A, Block 15") {
+		t.Errorf("Lot names should be kept as given, got %s", result)
+	}
+	d.Lot, d.Subdivision, d.City, d.County, d.State = "11", "Witt's Addition", "North Little Rock", "Pulaski", "Arkansas"
+	for _, letterCase := range []legal.Case{legal.TitleCase, legal.SentenceCase} {
+		d.Format.Case = letterCase
+		result, _ := d.Describe()
+		if want := "Lot 11, Block 15, Witt's Addition to the City of North Little Rock, Pulaski County, Arkansas, "; !strings.Contains(result, want) {
+			t.Errorf("Case %d should keep names given in mixed case, %s, got %s", letterCase, want, result)
+		}
+	}
+	d.Subdivision = "McArthur Park Addition"
+	if result, _ := d.Describe(); !strings.Contains(result, "McArthur Park Addition to the City of") {
+		t.Errorf("Names given in mixed case should be written exactly as given, got %s", result)
+	}
+	d.StartCoordinates = &[2]float64{1000.0, 5000.0}
+	for _, letterCase := range []legal.Case{legal.TitleCase, legal.SentenceCase} {
+		d.Format.Case = letterCase
+		result, _ := d.Describe()
+		if want := "oordinates N 5000.00 E 1000.00;"; !strings.Contains(result, want) {
+			t.Errorf("Case %d should keep coordinate labels in capitals, got %s", letterCase, result)
+		}
+	}
+}
+
+func TestCenterline(t *testing.T) {
+	var m1, m2 legal.LinearMete
+	m1.FromString(`THENCE (1) North 0°00'00" East, 100.00 feet`)
+	m2.FromString(`THENCE (2) North 90°00'00" East, 50.00 feet`)
+	d := legal.Description{Kind: "DRAINAGE EASEMENT", Lot: "1", Subdivision: "TEST ADDITION", Start: legal.SouthWest, StripWidth: 20.0, Centerline: true, Metes: []legal.Mete{&m1, &m2}}
+	result, err := d.Describe()
+	if err != nil {
+		t.Fatalf("Describe failed: %v", err)
+	}
+	want := "A 20-FOOT WIDE DRAINAGE EASEMENT LYING 10 FEET ON EACH SIDE OF THE FOLLOWING DESCRIBED CENTERLINE: BEGINNING AT THE SOUTHWEST CORNER OF SAID LOT 1; THENCE DUE NORTH"
+	if !strings.Contains(result, want) {
+		t.Errorf("Centerline description should contain %s, got %s", want, result)
+	}
+	want = "A DISTANCE OF 50.00 FEET TO THE POINT OF TERMINATION, CONTAINING 3000 SQUARE FEET MORE OR LESS."
+	if !strings.HasSuffix(result, want) {
+		t.Errorf("Centerline description should end with %s, got %s", want, result)
+	}
+	d.Format = legal.FormatOptions{CalculatedAreaTolerance: 1.0, StatePerimeter: true}
+	d.ShowClosing = true
+	result, err = d.Describe()
+	if err != nil || !strings.HasSuffix(result, want) {
+		t.Errorf("A centerline should state no calculated area, perimeter or closing line\nexpected suffix: %s\nerror: %v\nresult: %s", want, err, result)
+	}
+	d.Area, d.Unit = 3500.0, "SQUARE FEET"
+	result, _ = d.Describe()
+	if want := "CONTAINING 3500 SQUARE FEET MORE OR LESS (CALCULATED AREA: 3000.00 SQUARE FEET)."; !strings.HasSuffix(result, want) {
+		t.Errorf("A centerline should compare its stated area with that of the strip\nexpected suffix: %s\nresult: %s", want, result)
+	}
+	d.StripWidth = 0.0
+	d.Strict = true
+	if _, err := d.Describe(); err == nil {
+		t.Errorf("A centerline without a width should fail validation")
+	}
+}
+
+func TestAreaLineTrailingText(t *testing.T) {
+	report := `[INSERT PREAMBLE/CAPTION]:
+
+THENCE (1) North 0°00'00" East, 99.00 feet;
+
+THENCE (2) South 90°00'00" East, 110.00 feet;
+
+THENCE (3) South 0°00'00" West, 99.00 feet;
+
+THENCE (4) North 90°00'00" West, 110.00 feet;
+
+Closure area: 10890.00 Square   Feet; perimeter 418.00 feet`
+	for _, opts := range []legal.ParseOptions{legal.DefaultParseOptions, {Strict: true}} {
+		_, area, unit, err := legal.ParseReportWith(report, opts)
+		if err != nil {
+			t.Fatalf("ParseReport failed on an area line with trailing text: %v", err)
+		}
+		if area != 10890.0 || unit != "SQUARE FEET" {
+			t.Errorf("Area should be 10890 SQUARE FEET, got %v %q", area, unit)
+		}
+	}
+	_, _, unit, err := legal.ParseReport("caption\nContaining 0.25 acres more or less [VALUE IS GRID AREA]")
+	if err != nil || unit != "ACRES" {
+		t.Errorf("Area unit should be ACRES, got %q %v", unit, err)
+	}
+}
+
+func TestSpelledSymbols(t *testing.T) {
+	var b legal.Bearing
+	if err := b.FromString(`N 10°15'30" W`); err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		symbols legal.AngleSymbols
+		want    string
+	}{
+		{legal.ASCIISymbols, `NORTH 10°15'30.00" WEST`},
+		{legal.PrimeSymbols, "NORTH 10°15′30.00″ WEST"},
+		{legal.SpelledSymbols, "NORTH 10 DEG 15 MIN 30.00 SEC WEST"},
+	}
+	for _, c := range cases {
+		got := b.DescribeSymbols(c.symbols)
+		if got != c.want {
+			t.Errorf("Symbols %d should describe %s, got %s", c.symbols, c.want, got)
+		}
+		var parsed legal.Bearing
+		if err := parsed.FromString(got); err != nil || parsed.Describe() != b.Describe() {
+			t.Errorf("%s should parse back to %s, got %s (%v)", got, b.Describe(), parsed.Describe(), err)
+		}
+	}
+	for _, str := range []string{"N 10 DEG 15 MIN 30 SEC W", "N 10 DEGREES 15 MINUTES 30 SECONDS W", b.DescribeWords()} {
+		var parsed legal.Bearing
+		if err := parsed.FromString(str); err != nil || parsed.Describe() != b.Describe() {
+			t.Errorf("%s should parse to %s, got %s (%v)", str, b.Describe(), parsed.Describe(), err)
+		}
+	}
+	var mete legal.LinearMete
+	mete.FromString(`THENCE (1) North 2°02'36" East, 99.88 feet`)
+	if got := mete.DescribeWith(legal.FormatOptions{Symbols: legal.SpelledSymbols}); got != "NORTH 2 DEG 2 MIN 36.00 SEC EAST A DISTANCE OF 99.88 FEET" {
+		t.Errorf("Spelled symbols should be used in courses, got %s", got)
+	}
+}
+
+func TestInteriorAnglesConcave(t *testing.T) {
+	// an L-shaped parcel traversed clockwise, whose fourth corner is a reflex vertex
+	courses := []struct{ angle, dist float64 }{
+		{0.0, 100.0}, {math.Pi / 2.0, 50.0}, {math.Pi, 50.0}, {math.Pi / 2.0, 50.0}, {math.Pi, 50.0}, {3.0 * math.Pi / 2.0, 100.0},
+	}
+	var metes, reversed []legal.Mete
+	for i, course := range courses {
+		mete := legal.NewLinearMete(course.angle, course.dist, "feet")
+		metes = append(metes, &mete)
+		back := courses[len(courses)-1-i]
+		rev := legal.NewLinearMete(math.Mod(back.angle+math.Pi, 2.0*math.Pi), back.dist, "feet")
+		reversed = append(reversed, &rev)
+	}
+	d := legal.Description{PreTied: true, Metes: metes, Format: legal.FormatOptions{Courses: legal.InteriorAngles}}
+	result, err := d.Describe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `THENCE TURNING AN INTERIOR ANGLE OF 270°0'0.00" TO THE RIGHT, A DISTANCE OF 50.00 FEET`; !strings.Contains(result, want) {
+		t.Errorf("A reflex vertex should turn more than 180°\nexpected: %s\nresult:\n%s", want, result)
+	}
+	if n := strings.Count(result, `INTERIOR ANGLE OF 90°0'0.00" TO THE RIGHT`); n != 4 {
+		t.Errorf("The convex corners should each turn 90° to the right, found %d\n%s", n, result)
+	}
+	d.Metes = reversed
+	result, _ = d.Describe()
+	if want := `INTERIOR ANGLE OF 270°0'0.00" TO THE LEFT`; !strings.Contains(result, want) {
+		t.Errorf("A counterclockwise traverse should keep its interior on the left\nexpected: %s\nresult:\n%s", want, result)
+	}
+}
+
+func TestReverseMonuments(t *testing.T) {
+	// a square from its southwest corner, with a monument found at each corner
+	var metes []legal.Mete
+	for i, corner := range []string{"NW", "NE", "SE", "SW"} {
+		mete := legal.NewLinearMete(float64(i)*math.Pi/2.0, 100.0, "feet")
+		mete.EndMonument = "A FOUND IRON PIN AT " + corner
+		metes = append(metes, &mete)
+	}
+	d := legal.Description{Start: legal.SouthWest, Metes: metes}
+	reversed, err := d.Reverse()
+	if err != nil {
+		t.Fatalf("Failed to reverse description: %v", err)
+	}
+	// the reverse runs from the southwest corner east, then north, west and south
+	for i, corner := range []string{"SE", "NE", "NW", "SW"} {
+		if got := reversed.Metes[i].(*legal.LinearMete).EndMonument; got != "A FOUND IRON PIN AT "+corner {
+			t.Errorf("Reversed call %d should end at the pin at %s, got %q", i+1, corner, got)
+		}
+	}
+	result, err := reversed.Describe()
+	if want := "DUE EAST A DISTANCE OF 100.00 FEET TO A FOUND IRON PIN AT SE"; err != nil || !strings.Contains(result, want) {
+		t.Errorf("Reversed description should contain %s\nerror: %v\nresult:\n%s", want, err, result)
+	}
+	if metes[0].(*legal.LinearMete).EndMonument != "A FOUND IRON PIN AT NW" {
+		t.Errorf("Reversing should leave the original calls unchanged")
+	}
+}
+
+func TestCurvePrefix(t *testing.T) {
+	chord := `[INSERT PREAMBLE/CAPTION]:
+Course 1: North 0°00'00" East, 100.00 feet
+Curve 2: North 45°00'00" East, 35.36 feet
+Containing 2500.00 square feet, more or less`
+	var pe *legal.ParseError
+	if _, _, _, err := legal.ParseReport(chord); !errors.As(err, &pe) || pe.Line != 3 {
+		t.Errorf("A curve given only by its chord should fail to parse at line 3, got %v", err)
+	}
+	arc := `[INSERT PREAMBLE/CAPTION]:
+Course 1: North 0°00'00" East, 100.00 feet
+Curve 2: Length=47.12', Radius=30.00', Delta=90°00'00", Chord Bearing=N 45°00'00" E
+Containing 2500.00 square feet, more or less`
+	metes, _, _, err := legal.ParseReport(arc)
+	if err != nil || len(metes) != 2 {
+		t.Fatalf("ParseReport should read a curve by its radius and delta, got %v (%v)", metes, err)
+	}
+	curve, ok := metes[1].(*legal.ArcMete)
+	if !ok {
+		t.Fatalf("A curve should be read as an arc, got %T", metes[1])
+	}
+	if math.Abs(curve.ArcLength()-30.0*math.Pi/2.0) > 1e-9 || math.Abs(curve.ChordAngle()-math.Pi/4.0) > 1e-9 {
+		t.Errorf("Curve should have an arc length of %v along a chord at %v, got %v at %v", 30.0*math.Pi/2.0, math.Pi/4.0, curve.ArcLength(), curve.ChordAngle())
+	}
+}
+
+func TestParsePLSSDescription(t *testing.T) {
+	var mete1, mete2, mete3 legal.LinearMete
+	mete1.FromString(`THENCE (1) North 2°02'36" East, 99.88 feet`)
+	mete2.FromString(`THENCE (2) South 87°57'24" East, 50.00 feet`)
+	mete3.FromString(`THENCE (3) South 2°02'36" West, 99.88 feet`)
+	d := legal.Description{
+		Kind:        "TRACT",
+		Section:     "12",
+		Township:    "2 NORTH",
+		Range:       "11 WEST",
+		Meridian:    "FIFTH PRINCIPAL MERIDIAN",
+		AliquotPart: "NE 1/4",
+		County:      "PULASKI",
+		State:       "ARKANSAS",
+		Start:       legal.NorthWest,
+		Metes:       []legal.Mete{&mete1, &mete2, &mete3},
+		Area:        4994,
+		Unit:        "SQUARE FEET",
+	}
+	text, err := d.Describe()
+	if err != nil {
+		t.Fatalf("Failed to describe: %v", err)
+	}
+	parsed, err := legal.ParseDescription(text)
+	if err != nil {
+		t.Fatalf("Failed to parse description: %v\n%s", err, text)
+	}
+	if parsed.Section != d.Section || parsed.Township != d.Township || parsed.Range != d.Range || parsed.Meridian != d.Meridian ||
+		parsed.AliquotPart != d.AliquotPart || parsed.County != d.County || parsed.State != d.State || parsed.Start != d.Start {
+		t.Errorf("PLSS header was parsed incorrectly: %+v\n%s", parsed, text)
+	}
+	_, err = legal.ParseDescription("TRACT DESCRIPTION:\n\nSOMEWHERE NEAR THE RIVER, BEING MORE PARTICULARLY DESCRIBED AS FOLLOWS:\nBEGINNING AT THE NORTHWEST CORNER; THENCE NORTH 2°2'36.00\" EAST A DISTANCE OF 99.88 FEET TO THE POINT OF BEGINNING")
+	var pe *legal.ParseError
+	if !errors.As(err, &pe) || pe.Field != "header" || pe.Line != 3 {
+		t.Errorf("An unreadable header should be a parse error on line 3, got %v", err)
+	}
+}
+
+func TestNoLocation(t *testing.T) {
+	var mete1, mete2, mete3 legal.LinearMete
+	mete1.FromString(`THENCE (1) North 2°02'36" East, 99.88 feet`)
+	mete2.FromString(`THENCE (2) South 87°57'24" East, 50.00 feet`)
+	mete3.FromString(`THENCE (3) South 2°02'36" West, 99.88 feet`)
+	d := legal.Description{Kind: "TRACT", Start: legal.NorthWest, Metes: []legal.Mete{&mete1, &mete2, &mete3}, Area: 4994, Unit: "SQUARE FEET"}
+	result, err := d.Describe()
+	if err != nil {
+		t.Fatalf("Describe failed: %v", err)
+	}
+	if want := "A TRACT OF LAND BEING MORE PARTICULARLY DESCRIBED AS FOLLOWS:"; !strings.Contains(result, want) {
+		t.Errorf("A tract with no location should read %s, got %s", want, result)
+	}
+	if _, err := legal.ParseDescription(result); err != nil {
+		t.Errorf("A tract with no location should parse back: %v", err)
+	}
+	d.County = "PULASKI"
+	result, _ = d.Describe()
+	if want := "A TRACT OF LAND LYING IN PULASKI COUNTY, BEING MORE PARTICULARLY DESCRIBED AS FOLLOWS:"; !strings.Contains(result, want) {
+		t.Errorf("A tract with a county should read %s, got %s", want, result)
+	}
+	d.Lot = "7"
+	result, _ = d.Describe()
+	if want := "BEGINNING AT THE NORTHWEST CORNER OF SAID TRACT;"; !strings.Contains(result, want) || strings.Contains(result, "LOT") {
+		t.Errorf("A lot outside a subdivision is not stated in the header, so the beginning should read %s, got %s", want, result)
+	}
+}
@@ -1,108 +1,409 @@
 package main
 
 import (
+	"encoding/json"
 	"flag"
 	"fmt"
 	"io/ioutil"
-	"regexp"
+	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 
 	"github.com/skreimeyer/legal/pkg/legal"
 )
 
+// settings are the details of a description given on the command line, shared by every report processed
+type settings struct {
+	kind, cdir, cunit, lot, block, origin, sub, city, county, state string
+	cdist, areatol                                                  float64
+	areaunit, startdesc, order, punit, angleunit, letterCase, dms   string
+	perimeter, sixteen                                              bool
+}
+
+// config is the description metadata shared by the reports of a project, read from a JSON file such as
+//
+//	{"kind": "Drainage Easement", "sub": "Super Great Addition", "city": "North Little Rock", "county": "Pulaski", "state": "Arkansas"}
+type config struct {
+	Kind      string   `json:"kind"`
+	CDir      string   `json:"cdir"`
+	AngleUnit string   `json:"angleunit"`
+	CDist     *float64 `json:"cdist"`
+	CUnit     string   `json:"cunit"`
+	Lot       string   `json:"lot"`
+	Block     string   `json:"block"`
+	Origin    string   `json:"origin"`
+	// StartDesc describes a point of beginning which is not a lot corner, in place of Origin
+	StartDesc string   `json:"startdesc"`
+	Sub       string   `json:"sub"`
+	City      string   `json:"city"`
+	County    string   `json:"county"`
+	State     string   `json:"state"`
+	AreaUnit  string   `json:"areaunit"`
+	Case      string   `json:"case"`
+	DMS       string   `json:"dms-format"`
+	AreaTol   *float64 `json:"areatol"`
+	Perimeter *bool    `json:"perimeter"`
+	Sixteen   *bool    `json:"sixteen"`
+	Order     string   `json:"order"`
+	PUnit     string   `json:"punit"`
+}
+
+// outputSuffix names the description written beside each report in a batch
+const outputSuffix = ".legal.txt"
+
 func main() {
 	// init flags
 	usage := `legal
-	
+
 	Reads a 'metes and bounds report' from AutoCAD and prints a well-formatted legal description. Most command line flags are not optional or will not produce sensible results.
-	
+
 	basic usage:
-	legal -kind="Drainage Easement" -cdir=N1d2m3sE -cdist=10.0 -lot=1 -block=1 -origin=southeast -sub="Super Great Addition" REPORTFILE.txt`
-	kind := flag.String("kind", "", "Type of entity described, such as 'Temporary Construction Easement'")
-	cdir := flag.String("cdir", "",
-		"Bearing from point of commencement to point of beginning. Must follow the format N12d34m56sE {dir}{degree}d{minute}m{second}s{dir}")
-	cdist := flag.Float64("cdist", 0.0, "Distance along 'cdir' bearing from point of commencement to point of beginning")
-	lot := flag.String("lot", "", "Lot number (or letter)")
-	block := flag.String("block", "", "Block number (or letter)")
-	origin := flag.String("origin", "", "Cardinal direction of point of beginning or commencement of the lot being described (ie, northwest, east)")
-	sub := flag.String("sub", "", "Subdivision name")
+	legal -kind="Drainage Easement" -cdir=N1d2m3sE -cdist=10.0 -lot=1 -block=1 -origin=southeast -sub="Super Great Addition" -city="North Little Rock" -county=Pulaski -state=Arkansas REPORTFILE.txt
+
+	The report is read from standard input when REPORTFILE is "-" or omitted, and the description is written to
+	standard output unless -out is given.
+
+	Settings shared by a project may be kept in a JSON file given with -config, whose keys are the names of the flags
+	above. Flags given on the command line override the file.
+
+	batch usage:
+	legal [flags] -batch DIR
+	legal [flags] REPORT1.txt REPORT2.txt ...
+
+	Each report is described in a file of the same name ending in .legal.txt beside it, so -out is not allowed. A
+	report which can not be described is reported and skipped.
+
+	point file usage:
+	legal [flags] -points POINTS.csv -order 1,2,3,4
+
+	The parcel bounded by the listed points of a CSV file of point,northing,easting[,description] rows is described
+	with straight courses between them, beginning at the coordinates of the first point unless an origin is given.`
+	var s settings
+	flag.StringVar(&s.kind, "kind", "", "Type of entity described, such as 'Temporary Construction Easement'")
+	flag.StringVar(&s.cdir, "cdir", "",
+		"Bearing from point of commencement to point of beginning, given as N12d34m56sE {dir}{degree}d{minute}m{second}s{dir} or in decimal degrees as N12.5816E")
+	flag.StringVar(&s.angleunit, "angleunit", "degrees", "Unit of the 'cdir' bearing, either degrees or gon. A bearing in gon is given as an azimuth from north, ie 123.4567")
+	flag.Float64Var(&s.cdist, "cdist", 0.0, "Distance along 'cdir' bearing from point of commencement to point of beginning")
+	flag.StringVar(&s.cunit, "cunit", "FEET", "Unit of the 'cdist' distance, such as feet, meters or chains")
+	flag.StringVar(&s.lot, "lot", "", "Lot number (or letter)")
+	flag.StringVar(&s.block, "block", "", "Block number (or letter)")
+	flag.StringVar(&s.origin, "origin", "", "Cardinal direction of point of beginning or commencement of the lot being described (ie, northwest, east)")
+	flag.StringVar(&s.startdesc, "startdesc", "",
+		"Point of beginning or commencement when it is not a lot corner, such as 'a point on the north line of said lot'. Overrides 'origin'")
+	flag.StringVar(&s.sub, "sub", "", "Subdivision name")
+	flag.StringVar(&s.city, "city", "", "City in which the lot lies, if any")
+	flag.StringVar(&s.county, "county", "", "County in which the lot lies")
+	flag.StringVar(&s.state, "state", "", "State in which the lot lies")
+	flag.StringVar(&s.areaunit, "areaunit", "", "Unit in which the area is stated, such as acres, followed by the area as reported")
+	flag.StringVar(&s.letterCase, "case", "upper", "Capitalization of the description, either upper, title or sentence")
+	flag.StringVar(&s.dms, "dms-format", "symbols", "Marks of degrees, minutes and seconds, either symbols (10°15'30\"), prime (10°15′30″) or words (10 DEG 15 MIN 30 SEC)")
+	flag.Float64Var(&s.areatol, "areatol", 0.0, "State the area calculated from the calls when it differs from the reported area by more than this")
+	flag.BoolVar(&s.perimeter, "perimeter", false, "State the perimeter of the parcel after its area")
+	flag.BoolVar(&s.sixteen, "sixteen", false, "State the direction and concavity of curves on a sixteen point compass, ie north-northeasterly")
+	out := flag.String("out", "", "File to which the description is written. Defaults to standard output")
+	batch := flag.String("batch", "", "Directory of reports, each of which is described in a file beside it")
+	flag.StringVar(&s.order, "order", "", "Comma separated names of the points of a point file in the order they bound the parcel. Defaults to the order of the file")
+	flag.StringVar(&s.punit, "punit", "FEET", "Unit of the coordinates of a point file")
+	points := flag.String("points", "", "CSV point file of point,northing,easting[,description] rows to describe instead of a report")
+	configFile := flag.String("config", "", "JSON file of default settings, overridden by any flags given")
 	flag.Parse()
-	if len(flag.Args()) < 1 {
-		fmt.Println(usage)
-		fmt.Println("Arguments:")
-		flag.PrintDefaults()
+	if *configFile != "" {
+		err := applyConfig(*configFile, &s)
+		if err != nil {
+			fmt.Println("Invalid config file:", err)
+			return
+		}
+	}
+	if *points != "" {
+		data, err := ioutil.ReadFile(*points)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		legal, err := describePoints(string(data), s)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		write(legal, *out)
 		return
 	}
-	filename := flag.Args()[0]
-	data, err := ioutil.ReadFile(filename)
+	if *batch != "" || len(flag.Args()) > 1 {
+		if *out != "" {
+			fmt.Println("-out can not be used with more than one report, as each description is written beside its report")
+			os.Exit(1)
+		}
+		files := flag.Args()
+		if *batch != "" {
+			found, err := filepath.Glob(filepath.Join(*batch, "*.txt"))
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+			files = append(files, found...)
+		}
+		failed := describeAll(files, s)
+		if failed > 0 {
+			fmt.Printf("%d of %d reports could not be described\n", failed, len(files))
+			os.Exit(1)
+		}
+		return
+	}
+	filename := "-"
+	if len(flag.Args()) > 0 {
+		filename = flag.Args()[0]
+	}
+	var data []byte
+	var err error
+	if filename == "-" {
+		if stat, err := os.Stdin.Stat(); err == nil && stat.Mode()&os.ModeCharDevice != 0 {
+			// nothing is piped in, so the user most likely wants help
+			fmt.Println(usage)
+			fmt.Println("Arguments:")
+			flag.PrintDefaults()
+			return
+		}
+		data, err = ioutil.ReadAll(os.Stdin)
+	} else {
+		data, err = ioutil.ReadFile(filename)
+	}
 	if err != nil {
 		fmt.Println(err)
 		return
 	}
-	report := string(data)
+	legal, err := describe(string(data), s)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	write(legal, *out)
+}
+
+// write prints the description, or writes it to the named file when there is one
+func write(legal, out string) {
+	if out == "" {
+		fmt.Println(legal)
+		return
+	}
+	err := ioutil.WriteFile(out, []byte(legal+"\n"), 0644)
+	if err != nil {
+		fmt.Println("Failed to write description:", err)
+	}
+}
+
+// applyConfig fills in the settings from a config file, keeping those which were given as flags or which the file
+// leaves empty
+func applyConfig(filename string, s *settings) error {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	var c config
+	err = json.Unmarshal(data, &c)
+	if err != nil {
+		return err
+	}
+	given := make(map[string]bool)
+	flag.Visit(func(f *flag.Flag) { given[f.Name] = true })
+	for name, field := range map[string]struct {
+		dst *string
+		src string
+	}{
+		"kind":       {&s.kind, c.Kind},
+		"cdir":       {&s.cdir, c.CDir},
+		"angleunit":  {&s.angleunit, c.AngleUnit},
+		"cunit":      {&s.cunit, c.CUnit},
+		"lot":        {&s.lot, c.Lot},
+		"block":      {&s.block, c.Block},
+		"origin":     {&s.origin, c.Origin},
+		"startdesc":  {&s.startdesc, c.StartDesc},
+		"sub":        {&s.sub, c.Sub},
+		"city":       {&s.city, c.City},
+		"county":     {&s.county, c.County},
+		"state":      {&s.state, c.State},
+		"areaunit":   {&s.areaunit, c.AreaUnit},
+		"case":       {&s.letterCase, c.Case},
+		"dms-format": {&s.dms, c.DMS},
+		"order":      {&s.order, c.Order},
+		"punit":      {&s.punit, c.PUnit},
+	} {
+		if !given[name] && field.src != "" {
+			*field.dst = field.src
+		}
+	}
+	for name, field := range map[string]struct {
+		dst *float64
+		src *float64
+	}{
+		"cdist":   {&s.cdist, c.CDist},
+		"areatol": {&s.areatol, c.AreaTol},
+	} {
+		if !given[name] && field.src != nil {
+			*field.dst = *field.src
+		}
+	}
+	for name, field := range map[string]struct {
+		dst *bool
+		src *bool
+	}{
+		"perimeter": {&s.perimeter, c.Perimeter},
+		"sixteen":   {&s.sixteen, c.Sixteen},
+	} {
+		if !given[name] && field.src != nil {
+			*field.dst = *field.src
+		}
+	}
+	return nil
+}
+
+// describeAll writes a description beside each report file, printing the error for any that fail rather than
+// stopping. It returns the number of reports which failed.
+func describeAll(files []string, s settings) int {
+	failed := 0
+	for _, filename := range files {
+		if strings.HasSuffix(filename, outputSuffix) {
+			continue // output of an earlier run
+		}
+		err := describeFile(filename, s)
+		if err != nil {
+			fmt.Printf("%s: %v\n", filename, err)
+			failed++
+		}
+	}
+	return failed
+}
+
+// describeFile describes a single report file into a file of the same name ending in outputSuffix
+func describeFile(filename string, s settings) error {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	legal, err := describe(string(data), s)
+	if err != nil {
+		return err
+	}
+	target := strings.TrimSuffix(filename, filepath.Ext(filename)) + outputSuffix
+	return ioutil.WriteFile(target, []byte(legal+"\n"), 0644)
+}
+
+// describe produces the legal description of a report
+func describe(report string, s settings) (string, error) {
+	calls, area, units, err := legal.ParseReport(report)
+	if err != nil {
+		return "", err
+	}
+	return describeParcel(&legal.Description{Area: area, Unit: strings.ToUpper(units), Metes: calls}, s)
+}
+
+// describePoints produces the legal description of the parcel bounded by the points of a point file. The description
+// begins at the coordinates of the first point unless an origin or starting point is given, and commences from the
+// start of the commencement tie leading to that point when one is given. The computed area is stated to hundredths.
+func describePoints(file string, s settings) (string, error) {
+	points, err := legal.ParsePoints(strings.NewReader(file))
+	if err != nil {
+		return "", err
+	}
+	var order []string
+	if s.order != "" {
+		order = strings.Split(s.order, ",")
+	}
+	ordered, err := legal.OrderPoints(points, order)
+	if err != nil {
+		return "", err
+	}
+	desc, err := legal.DescriptionFromPoints(ordered, s.punit)
+	if err != nil {
+		return "", err
+	}
+	if s.origin != "" || s.startdesc != "" {
+		desc.StartCoordinates = nil
+	}
+	desc.Format.Precision = &legal.Precision{Distance: 2, Area: 2, Seconds: 2}
+	return describeParcel(desc, s)
+}
+
+// describeParcel completes the description of a parcel from the settings and describes it
+func describeParcel(desc *legal.Description, s settings) (string, error) {
 	var metes []legal.Mete
-	if *cdir != "" {
-		var commBearing legal.Bearing
-		err = commBearing.FromString(*cdir)
+	if s.cdir != "" {
+		commBearing, err := commencementBearing(s)
 		if err != nil {
-			fmt.Println("Invalid commencement bearing")
-			return
+			return "", err
 		}
-		angle := commBearing.ToAngle()
-		commDist := *cdist
-		comm := legal.NewLinearMete(angle, commDist, "FEET")
-		metes = append(metes, &comm) // FIXME: allow other units
-	}
-	var area float64
-	var units string
-	distdir := regexp.MustCompile(`(\d+\.?\d*)\s?([A-Za-z ]+)`)
-	for i, l := range strings.Split(report, "\n") {
-		if i == 0 || len(l) < 1 {
-			continue
-		}
-		if l[0] == 'T' {
-			mete := Mete{}
-			err = mete.Parse(l)
-			if err != nil {
-				fmt.Println(err)
-				return
-			}
-			metes = append(metes, mete)
+		comm := legal.NewLinearMete(commBearing.ToAngle(), s.cdist, strings.ToUpper(s.cunit))
+		metes = append(metes, &comm)
+	}
+	start, ok := legal.CornerFromString(s.origin)
+	if !ok && s.startdesc == "" && desc.StartCoordinates == nil {
+		return "", fmt.Errorf("Invalid origin %q", s.origin)
+	}
+	desc.Kind = strings.ToUpper(s.kind)
+	desc.Lot = strings.ToUpper(s.lot)
+	desc.Block = strings.ToUpper(s.block)
+	desc.Subdivision = strings.ToUpper(s.sub)
+	desc.City = strings.ToUpper(s.city)
+	desc.County = strings.ToUpper(s.county)
+	desc.State = strings.ToUpper(s.state)
+	desc.Start = start
+	desc.Commencement = s.cdir != "" || s.cdist != 0.0
+	desc.StartDescription = s.startdesc
+	desc.Metes = append(metes, desc.Metes...)
+	desc.Format.StatePerimeter = s.perimeter
+	desc.Format.SixteenPoints = s.sixteen
+	desc.Format.CalculatedAreaTolerance = s.areatol
+	switch strings.ToLower(s.letterCase) {
+	case "", "upper":
+	case "title":
+		desc.Format.Case = legal.TitleCase
+	case "sentence":
+		desc.Format.Case = legal.SentenceCase
+	default:
+		return "", fmt.Errorf("Invalid case %q", s.letterCase)
+	}
+	switch strings.ToLower(s.dms) {
+	case "", "symbols":
+	case "prime":
+		desc.Format.Symbols = legal.PrimeSymbols
+	case "words":
+		desc.Format.Symbols = legal.SpelledSymbols
+	default:
+		return "", fmt.Errorf("Invalid DMS format %q", s.dms)
+	}
+	if s.areaunit != "" {
+		unit, ok := legal.AreaUnitFromString(s.areaunit)
+		if !ok {
+			return "", fmt.Errorf("Invalid area unit %q", s.areaunit)
+		}
+		desc.Format.AreaUnits = []string{unit.Describe()}
+		if reported, ok := legal.AreaUnitFromString(desc.Unit); ok && reported != unit {
+			desc.Format.AreaUnits = append(desc.Format.AreaUnits, reported.Describe())
 		}
-		if l[0] == 'C' {
-			values := distdir.FindStringSubmatch(l)
-			if len(values) != 3 {
-				fmt.Println("Invalid area description. Area matches:", values)
-				return
-			}
-			area, err = strconv.ParseFloat(values[1], 64)
-			if err != nil {
-				fmt.Println("Invalid area description", err)
-			}
-			units = values[2]
-		}
-	}
-	hasCommencement := *cdir != "" || *cdist != 0.0
-	desc := Description{
-		Kind:         strings.ToUpper(*kind),
-		Lot:          strings.ToUpper(*lot),
-		Block:        strings.ToUpper(*block),
-		Subdivision:  strings.ToUpper(*sub),
-		City:         "NORTH LITTLE ROCK",
-		County:       "PULASKI",
-		State:        "ARKANSAS",
-		Start:        strings.ToUpper(*origin),
-		Commencement: hasCommencement,
-		Area:         area,
-		Unit:         strings.ToUpper(units),
-		Metes:        metes,
 	}
 	legal, err := desc.Describe()
 	if err != nil {
-		fmt.Println("Failed to generate description:%w", err)
-		return
+		return "", fmt.Errorf("Failed to generate description: %v", err)
+	}
+	return legal, nil
+}
+
+// commencementBearing reads the bearing from the point of commencement to the point of beginning in its angular unit
+func commencementBearing(s settings) (legal.Bearing, error) {
+	var b legal.Bearing
+	switch strings.ToLower(s.angleunit) {
+	case "", "degrees", "deg":
+		if err := b.FromString(s.cdir); err != nil {
+			return b, fmt.Errorf("Invalid commencement bearing")
+		}
+	case "gon", "gons", "grad", "gradians":
+		gon, err := strconv.ParseFloat(strings.TrimSpace(s.cdir), 64)
+		if err != nil {
+			return b, fmt.Errorf("Invalid commencement bearing %q in gon", s.cdir)
+		}
+		b.FromGon(gon)
+	default:
+		return b, fmt.Errorf("Invalid angle unit %q", s.angleunit)
 	}
-	fmt.Println(legal)
-	return
+	return b, nil
 }
@@ -0,0 +1,162 @@
+package legal
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// smallWords are the connecting words which are not capitalized in title case unless they begin a sentence
+var smallWords = map[string]bool{
+	"A": true, "AN": true, "AND": true, "AS": true, "AT": true, "BY": true, "FOR": true, "FROM": true, "IN": true,
+	"OF": true, "ON": true, "OR": true, "THE": true, "TO": true, "WITH": true,
+}
+
+// regCaseBearing finds the directions of bearings and the labels of coordinates, ie N 5000.00 E 1000.00, which keep
+// their capitals in sentence and title case
+var regCaseBearing = regexp.MustCompile(`\b(NORTH|SOUTH) \d+(?:°\S*| DEG \d+ MIN \S+ SEC) (EAST|WEST)\b|\bDUE (NORTH|SOUTH|EAST|WEST)\b|\b(N) -?\d+\.?\d* (E) -?\d`)
+
+// capitalization marks for each byte of a description being recased
+const (
+	lowerMark = iota
+	capitalMark
+	keepMark
+)
+
+// keyedName is a name which is written as given following a keyword, ie LOT A
+type keyedName struct {
+	keyword, name string
+}
+
+// properNouns are the names in the description which keep their capitals in sentence case, along with those which are
+// written exactly as given, such as lot numbers and aliquot parts, and the names which were not given in capitals and
+// so are written exactly as given too
+func (d *Description) properNouns() (nouns []string, keyed []keyedName, given []string) {
+	for _, name := range []string{d.Subdivision, d.City, d.County, d.State, d.Township, d.Range, d.Meridian} {
+		if name != strings.ToUpper(name) {
+			given = append(given, name)
+		}
+	}
+	for _, noun := range []string{d.Subdivision, d.State, d.Meridian} {
+		if noun != "" {
+			nouns = append(nouns, noun)
+		}
+	}
+	if d.City != "" {
+		nouns = append(nouns, "CITY OF "+d.City)
+	}
+	if d.County != "" {
+		nouns = append(nouns, d.County+" COUNTY")
+	}
+	if d.Township != "" {
+		nouns = append(nouns, "TOWNSHIP "+d.Township)
+	}
+	if d.Range != "" {
+		nouns = append(nouns, "RANGE "+d.Range)
+	}
+	for _, k := range []keyedName{{"LOT", d.Lot}, {"BLOCK", d.Block}, {"SECTION", d.Section}, {"", d.AliquotPart}} {
+		if k.name != "" {
+			keyed = append(keyed, k)
+		}
+	}
+	for _, e := range d.Exceptions {
+		n, k, g := e.properNouns()
+		nouns = append(nouns, n...)
+		keyed = append(keyed, k...)
+		given = append(given, g...)
+	}
+	return nouns, keyed, given
+}
+
+// recase rewrites a description written in capitals in the case of the format
+func (d *Description) recase(text string) string {
+	if d.Format.Case == UpperCase {
+		return text
+	}
+	nouns, keyed, given := d.properNouns()
+	marks := make([]byte, len(text))
+	markWords(text, marks, 0, len(text), d.Format.Case == TitleCase)
+	if d.Format.Case == SentenceCase && len(nouns) > 0 {
+		quoted := make([]string, len(nouns))
+		for i, noun := range nouns {
+			quoted[i] = regexp.QuoteMeta(noun)
+		}
+		for _, loc := range regexp.MustCompile(`(?i)\b(?:`+strings.Join(quoted, "|")+`)\b`).FindAllStringIndex(text, -1) {
+			markWords(text, marks, loc[0], loc[1], true)
+		}
+	}
+	for _, m := range regCaseBearing.FindAllStringSubmatchIndex(text, -1) {
+		for i := 2; i < len(m); i += 2 {
+			if m[i] != -1 {
+				marks[m[i]] = capitalMark
+			}
+		}
+	}
+	if len(keyed) > 0 {
+		alternatives := make([]string, len(keyed))
+		for i, k := range keyed {
+			alternatives[i] = regexp.QuoteMeta(k.keyword) + ` ?(` + regexp.QuoteMeta(k.name) + `)`
+		}
+		for _, m := range regexp.MustCompile(`(?i)\b(?:`+strings.Join(alternatives, "|")+`)\b`).FindAllStringSubmatchIndex(text, -1) {
+			marks[m[0]] = capitalMark
+			for i := 2; i < len(m); i += 2 {
+				for j := m[i]; j >= 0 && j < m[i+1]; j++ {
+					marks[j] = keepMark
+				}
+			}
+		}
+	}
+	if len(given) > 0 {
+		quoted := make([]string, len(given))
+		for i, name := range given {
+			quoted[i] = regexp.QuoteMeta(name)
+		}
+		for _, loc := range regexp.MustCompile(`\b(?:`+strings.Join(quoted, "|")+`)\b`).FindAllStringIndex(text, -1) {
+			for j := loc[0]; j < loc[1]; j++ {
+				marks[j] = keepMark
+			}
+		}
+	}
+	var result strings.Builder
+	result.Grow(len(text))
+	for i, r := range text {
+		switch marks[i] {
+		case capitalMark:
+			result.WriteRune(unicode.ToUpper(r))
+		case keepMark:
+			result.WriteRune(r)
+		default:
+			result.WriteRune(unicode.ToLower(r))
+		}
+	}
+	return result.String()
+}
+
+// markWords marks the letters of text between start and end which begin a sentence for capitals. When every word is
+// set, the first letter of every word other than a small word in the middle of a sentence is marked too.
+func markWords(text string, marks []byte, start, end int, every bool) {
+	sentence := start == 0 // the next word begins a sentence
+	prev := rune(0)
+	for i := start; i < end; {
+		r, size := utf8.DecodeRuneInString(text[i:])
+		switch {
+		case unicode.IsLetter(r) && !unicode.IsLetter(prev) && !unicode.IsDigit(prev) && prev != '\'':
+			word := text[i:]
+			if n := strings.IndexFunc(word, func(c rune) bool { return !unicode.IsLetter(c) && c != '\'' }); n != -1 {
+				word = word[:n]
+			}
+			if sentence || (every && (i == start || !smallWords[word])) {
+				marks[i] = capitalMark
+			}
+			sentence = false
+		case r == '.' || r == ':' || r == '\n':
+			sentence = i+size == len(text) || unicode.IsSpace(rune(text[i+size])) || r == '\n'
+		case unicode.IsSpace(r) || r == '(' || r == '"':
+		case !unicode.IsLetter(r):
+			sentence = false
+		}
+		prev = r
+		i += size
+	}
+}
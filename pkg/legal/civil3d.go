@@ -0,0 +1,148 @@
+package legal
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	regCivilLength   = regexp.MustCompile(`(?i)\bLength\s*[:=]\s*(\d+\.?\d*)\s*('|[a-zA-Z]*)`)
+	regCivilRadius   = regexp.MustCompile(`(?i)\bRadius\s*[:=]\s*(\d+\.?\d*)`)
+	regCivilDelta    = regexp.MustCompile(`(?i)\bDelta\s*[:=]\s*(\S+)`)
+	regCivilChord    = regexp.MustCompile(`(?i)\b(?:Chord\s+)?(?:Course|Bearing)\s*[:=]\s*([NS][^,]*?[EW])\b`)
+	regCivilRotation = regexp.MustCompile(`(?i)\b(Left|Right)\b`)
+)
+
+// ParseCivil3D reads the calls of a Civil 3D parcel report. Lines are given as
+//
+//	Course: N 10°15'30" W Length: 65.00'
+//
+// and curves as
+//
+//	Curve: Length=47.12', Radius=30.00', Delta=90°00'00", Chord Bearing=S 45°00'00" E
+//
+// Any other line is ignored. The delta of a curve may be omitted in favour of its length and radius. A curve turning
+// "Left" or "Right" is counterclockwise or clockwise, otherwise its direction is inferred from its chord bearing and
+// the call before it. A curve without a chord bearing is presumed tangent to the call before it.
+func ParseCivil3D(report string) ([]Mete, error) {
+	var metes []Mete
+	for i, l := range strings.Split(report, "\n") {
+		l = strings.TrimSpace(l)
+		lower := strings.ToLower(l)
+		switch {
+		case strings.HasPrefix(lower, "course"):
+			mete, err := parseCivilCourse(l)
+			if err != nil {
+				return nil, atLine(err, i+1, "call", l)
+			}
+			metes = append(metes, mete)
+		case strings.HasPrefix(lower, "curve"):
+			var prev Mete
+			if len(metes) > 0 {
+				prev = metes[len(metes)-1]
+			}
+			mete, err := parseCivilCurve(l, prev)
+			if err != nil {
+				return nil, atLine(err, i+1, "call", l)
+			}
+			metes = append(metes, mete)
+		}
+	}
+	return metes, nil
+}
+
+// civilLengthIndex locates the length of a call, skipping any chord length, and returns the indices of its submatches
+func civilLengthIndex(line string) []int {
+	for _, loc := range regCivilLength.FindAllStringSubmatchIndex(line, -1) {
+		if !strings.HasSuffix(strings.ToLower(strings.TrimSpace(line[:loc[0]])), "chord") {
+			return loc
+		}
+	}
+	return nil
+}
+
+// civilLength reads the length of a call and its unit. Feet may be marked with an apostrophe or left unstated.
+func civilLength(line string) (float64, string, error) {
+	loc := civilLengthIndex(line)
+	if loc == nil {
+		return 0.0, "", fmt.Errorf("no length in %q", line)
+	}
+	length, err := strconv.ParseFloat(line[loc[2]:loc[3]], 64)
+	if err != nil {
+		return 0.0, "", err
+	}
+	unit := line[loc[4]:loc[5]]
+	if unit == "" || unit == "'" {
+		unit = "feet"
+	}
+	return length, unit, nil
+}
+
+// parseCivilCourse reads a straight call
+func parseCivilCourse(line string) (*LinearMete, error) {
+	loc := civilLengthIndex(line)
+	if loc == nil {
+		return nil, fmt.Errorf("no length in %q", line)
+	}
+	var b Bearing
+	err := b.FromString(line[strings.Index(line, ":")+1 : loc[0]])
+	if err != nil {
+		return nil, err
+	}
+	length, unit, err := civilLength(line)
+	if err != nil {
+		return nil, err
+	}
+	mete := NewLinearMete(b.ToAngle(), length, unit)
+	return &mete, nil
+}
+
+// parseCivilCurve reads a curve following the call prev, which may be nil
+func parseCivilCurve(line string, prev Mete) (*ArcMete, error) {
+	length, unit, err := civilLength(line)
+	if err != nil {
+		return nil, err
+	}
+	subs := regCivilRadius.FindStringSubmatch(line)
+	if subs == nil {
+		return nil, fmt.Errorf("no radius in %q", line)
+	}
+	radius, err := strconv.ParseFloat(subs[1], 64)
+	if err != nil {
+		return nil, err
+	}
+	if radius <= 0.0 {
+		return nil, fmt.Errorf("radius must be positive")
+	}
+	delta := length / radius
+	if subs := regCivilDelta.FindStringSubmatch(line); subs != nil {
+		delta, err = parseDMS(strings.TrimSuffix(subs[1], ","))
+		if err != nil {
+			return nil, err
+		}
+	}
+	prevTangent, hasPrev := 0.0, prev != nil
+	if hasPrev {
+		prevTangent = endTangent(prev)
+	}
+	rot := Clockwise
+	if subs := regCivilRotation.FindStringSubmatch(line); subs != nil && strings.EqualFold(subs[1], "Left") {
+		rot = CounterClockwise
+	}
+	subs = regCivilChord.FindStringSubmatch(line)
+	if subs == nil {
+		return NewArcMete(delta, radius, prevTangent, unit, rot), nil
+	}
+	var chord Bearing
+	err = chord.FromString(subs[1])
+	if err != nil {
+		return nil, err
+	}
+	if hasPrev && !regCivilRotation.MatchString(line) && math.Remainder(chord.ToAngle()-prevTangent, 2.0*math.Pi) < 0.0 {
+		rot = CounterClockwise
+	}
+	return NewArcMete(delta, radius, chord.ToAngle()-float64(rot)*delta/2.0, unit, rot), nil
+}
@@ -0,0 +1,49 @@
+package legal
+
+import "math"
+
+// CurveDefinition is a convention relating the degree of a curve to its radius
+type CurveDefinition int
+
+// Curve definitions. Under the arc definition, usual for highways, the degree of curve is the central angle subtended
+// by an arc of 100 units. Under the chord definition, usual for railroads, it is the central angle subtended by a
+// chord of 100 units.
+const (
+	ArcDefinition CurveDefinition = iota
+	ChordDefinition
+)
+
+// stationLength is the length of arc or chord over which the degree of a curve is measured
+const stationLength = 100.0
+
+// Radius is the radius of a curve with the given degree of curve, in decimal degrees
+func (def CurveDefinition) Radius(degreeOfCurve float64) float64 {
+	d := degreeOfCurve * math.Pi / 180.0
+	if def == ChordDefinition {
+		return stationLength / 2.0 / math.Sin(d/2.0)
+	}
+	return stationLength / d
+}
+
+// Degree is the degree of curve, in decimal degrees, of a curve with the given radius
+func (def CurveDefinition) Degree(radius float64) float64 {
+	var d float64
+	if def == ChordDefinition {
+		d = 2.0 * math.Asin(stationLength/2.0/radius)
+	} else {
+		d = stationLength / radius
+	}
+	return d * 180.0 / math.Pi
+}
+
+// NewArcMeteFromDegreeOfCurve creates an arc from its degree of curve in decimal degrees under the arc definition,
+// such that a curve of 1° has a radius of 5729.58 units. The central angle and tangent are in radians as for
+// NewArcMete. Curves given under the chord definition can be created with NewArcMete and ChordDefinition.Radius.
+func NewArcMeteFromDegreeOfCurve(degreeOfCurve, delta, tangent float64, unit string, rot Rotation) *ArcMete {
+	return NewArcMete(delta, ArcDefinition.Radius(degreeOfCurve), tangent, unit, rot)
+}
+
+// DegreeOfCurve is the degree of curve of the arc in decimal degrees under the arc definition
+func (am *ArcMete) DegreeOfCurve() float64 {
+	return ArcDefinition.Degree(am.radius)
+}
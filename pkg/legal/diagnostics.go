@@ -0,0 +1,81 @@
+package legal
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+)
+
+const (
+	// minClosureRatio is the worst precision at which a traverse is considered to close, ie 1:10000
+	minClosureRatio = 10000.0
+	// maxAreaDiscrepancy is the largest fraction of the stated area by which the computed area may differ
+	maxAreaDiscrepancy = 0.01
+)
+
+// Diagnostics is a structured health check of a description. Errors prevent a sensible description from being
+// produced, while warnings flag descriptions which are likely to be mistaken.
+type Diagnostics struct {
+	Errors   []string `json:"errors"`
+	Warnings []string `json:"warnings"`
+	// ClosureRatio is the perimeter divided by the linear misclosure, or zero when the traverse closes exactly or is
+	// an open centerline
+	ClosureRatio float64 `json:"closure_ratio"`
+	// AreaDiscrepancy is the stated area less the computed area, in the unit of the stated area
+	AreaDiscrepancy float64 `json:"area_discrepancy"`
+}
+
+// Diagnostics checks the description for the errors found by Validate, misclosure of its boundary and disagreement
+// between its stated and computed areas. A centerline is not expected to close, and its stated area is checked
+// against the area of its strip.
+func (d *Description) Diagnostics() Diagnostics {
+	diag := Diagnostics{Errors: []string{}, Warnings: []string{}}
+	metes := d.boundary()
+	if len(metes) == 0 {
+		diag.Errors = append(diag.Errors, "no metes describe the boundary")
+		return diag
+	}
+	if err := d.Validate(); err != nil {
+		diag.Errors = append(diag.Errors, err.Error())
+	}
+	if !d.Centerline {
+		dx, dy, err := closure([2]float64{0.0, 0.0}, metes)
+		if err != nil {
+			diag.Errors = append(diag.Errors, err.Error())
+			return diag
+		}
+		perimeter, err := Perimeter(metes)
+		if err != nil {
+			diag.Errors = append(diag.Errors, err.Error())
+			return diag
+		}
+		if misclosure := math.Hypot(dx, dy); misclosure > 0.0 {
+			diag.ClosureRatio = perimeter / misclosure
+			if diag.ClosureRatio < minClosureRatio {
+				diag.Warnings = append(diag.Warnings, fmt.Sprintf("misclosure of %.2f gives a precision of 1:%.0f, worse than 1:%.0f", misclosure, diag.ClosureRatio, minClosureRatio))
+			}
+		}
+	}
+	if d.Centerline && d.Area == 0.0 {
+		return diag // the area of the strip is stated as computed
+	}
+	area, diff, _, err := d.AreaCheck(0.0)
+	if err != nil {
+		diag.Errors = append(diag.Errors, err.Error())
+		return diag
+	}
+	if d.Area == 0.0 {
+		diag.Warnings = append(diag.Warnings, "no area is stated")
+		return diag
+	}
+	diag.AreaDiscrepancy = diff
+	if math.Abs(diff) > maxAreaDiscrepancy*d.Area {
+		diag.Warnings = append(diag.Warnings, fmt.Sprintf("stated area %.2f differs from computed area %.2f", d.Area, area))
+	}
+	return diag
+}
+
+// DiagnosticsJSON encodes the diagnostics of the description for tooling
+func (d *Description) DiagnosticsJSON() ([]byte, error) {
+	return json.Marshal(d.Diagnostics())
+}
@@ -0,0 +1,45 @@
+package legal
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ParseError describes text which could not be parsed. Line is the line of the report on which the text appears, or
+// zero when it was not read from a report. Field names the part of the text which is invalid, such as "bearing",
+// "distance" or "area", and Token is the raw text of that part.
+type ParseError struct {
+	Line  int
+	Field string
+	Token string
+	Err   error
+}
+
+// Error states the field and token which could not be parsed, along with the line and cause when they are known
+func (e *ParseError) Error() string {
+	msg := fmt.Sprintf("invalid %s %q", e.Field, e.Token)
+	if e.Err != nil {
+		msg += ": " + e.Err.Error()
+	}
+	if e.Line > 0 {
+		msg = fmt.Sprintf("line %d: %s", e.Line, msg)
+	}
+	return msg
+}
+
+// Unwrap returns the cause of the parse error
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// atLine places an error on a line of a report. A parse error keeps its field and token, and any other error becomes
+// the cause of a parse error of the given field and token.
+func atLine(err error, line int, field, token string) error {
+	var pe *ParseError
+	if errors.As(err, &pe) {
+		located := *pe
+		located.Line = line
+		return &located
+	}
+	return &ParseError{Line: line, Field: field, Token: token, Err: err}
+}
@@ -0,0 +1,257 @@
+package legal
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// esriPolygon is the subset of an Esri JSON polygon geometry needed to represent a single parcel
+type esriPolygon struct {
+	Rings [][][2]float64 `json:"rings"`
+}
+
+// ArcGISJSON encodes the parcel boundary as an Esri JSON polygon. The point of beginning is placed at start, given as
+// (easting, northing), and arcs are densified into chords. The ring is wound clockwise, as Esri reads a
+// counterclockwise ring as a hole.
+func (d *Description) ArcGISJSON(start [2]float64) ([]byte, error) {
+	ring, err := traverse(start, d.boundary(), densifyChord)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(esriPolygon{Rings: [][][2]float64{windRing(closeRing(ring), true)}})
+}
+
+// windRing reverses a closed ring when needed so that it is wound clockwise or counterclockwise
+func windRing(ring [][2]float64, clockwise bool) [][2]float64 {
+	twiceArea := 0.0
+	for i := 1; i < len(ring); i++ {
+		twiceArea += ring[i-1][0]*ring[i][1] - ring[i][0]*ring[i-1][1]
+	}
+	if (clockwise && twiceArea > 0.0) || (!clockwise && twiceArea < 0.0) {
+		for i, j := 0, len(ring)-1; i < j; i, j = i+1, j-1 {
+			ring[i], ring[j] = ring[j], ring[i]
+		}
+	}
+	return ring
+}
+
+// CallsCSV tabulates the calls of a description with a header row. Lines are given by their bearing and distance,
+// while arcs are given by their chord bearing and arc length along with their radius and central angle.
+func (d *Description) CallsCSV() string {
+	var result bytes.Buffer
+	w := csv.NewWriter(&result)
+	w.Write([]string{"bearing", "distance", "unit", "radius", "delta"})
+	for _, m := range flatten(d.Metes) {
+		var b Bearing
+		switch mete := m.(type) {
+		case *LinearMete:
+			b.FromAngle(mete.bearing)
+			w.Write([]string{b.Compact(), csvFloat(mete.distance), strings.ToUpper(mete.unit), "", ""})
+		case *ArcMete:
+			b.FromAngle(mete.ChordAngle())
+			w.Write([]string{b.Compact(), csvFloat(mete.ArcLength()), strings.ToUpper(mete.unit), csvFloat(mete.radius), compactAngle(mete.centralAngle)})
+		}
+	}
+	w.Flush()
+	return result.String()
+}
+
+// csvFloat formats a distance for tabular output
+func csvFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', 2, 64)
+}
+
+// compactAngle formats an angle in radians as degrees, minutes and seconds separated by dashes, ie 90-26-30
+func compactAngle(angle float64) string {
+	degrees, minutes, seconds := dmsParts(angle, 2)
+	return fmt.Sprintf("%d-%d-%s", degrees, minutes, strconv.FormatFloat(seconds, 'f', -1, 64))
+}
+
+// svgMargin is the space in pixels left around the parcel in an SVG sketch
+const svgMargin = 20.0
+
+// SVG sketches the parcel boundary to scale with north up, fitting it within an image width by height pixels. The
+// point of beginning is placed at origin and is marked and labelled POB. Arcs are drawn as true SVG arcs and each
+// course is labelled with its number at its midpoint.
+func (d *Description) SVG(origin Point, width, height int) ([]byte, error) {
+	metes := flatten(d.boundary())
+	start := [2]float64{origin.X, origin.Y}
+	vertices, err := Coordinates(start, metes)
+	if err != nil {
+		return nil, err
+	}
+	if len(vertices) < 2 {
+		return nil, fmt.Errorf("no metes describe the boundary")
+	}
+	if float64(width) <= 2*svgMargin || float64(height) <= 2*svgMargin {
+		return nil, fmt.Errorf("%d by %d pixels leaves no room for the sketch", width, height)
+	}
+	// the bounds are taken from the densified boundary so that the bulge of each arc fits in the image
+	ring, err := traverse(start, metes, densifyChord)
+	if err != nil {
+		return nil, err
+	}
+	minX, maxX, minY, maxY := ring[0][0], ring[0][0], ring[0][1], ring[0][1]
+	for _, p := range ring[1:] {
+		minX, maxX = math.Min(minX, p[0]), math.Max(maxX, p[0])
+		minY, maxY = math.Min(minY, p[1]), math.Max(maxY, p[1])
+	}
+	if maxX-minX == 0.0 && maxY-minY == 0.0 {
+		return nil, fmt.Errorf("boundary has no extent")
+	}
+	scale := math.Inf(1)
+	if maxX > minX {
+		scale = (float64(width) - 2*svgMargin) / (maxX - minX)
+	}
+	if maxY > minY {
+		scale = math.Min(scale, (float64(height)-2*svgMargin)/(maxY-minY))
+	}
+	// center the sketch in whichever dimension it does not fill
+	offsetX := (float64(width) - (maxX-minX)*scale) / 2.0
+	offsetY := (float64(height) - (maxY-minY)*scale) / 2.0
+	// svg coordinates increase downward, so northings are flipped to keep north up
+	px := func(p [2]float64) (float64, float64) {
+		return (p[0]-minX)*scale + offsetX, (maxY-p[1])*scale + offsetY
+	}
+	var path, labels bytes.Buffer
+	x, y := px(vertices[0])
+	fmt.Fprintf(&path, "M%.2f %.2f", x, y)
+	for i, m := range metes {
+		x, y = px(vertices[i+1])
+		mid := [2]float64{(vertices[i][0] + vertices[i+1][0]) / 2.0, (vertices[i][1] + vertices[i+1][1]) / 2.0}
+		if arc, ok := m.(*ArcMete); ok {
+			large, sweep := 0, 0
+			if arc.centralAngle > math.Pi {
+				large = 1
+			}
+			if arc.dir == Clockwise {
+				sweep = 1
+			}
+			r := arc.radius * scale
+			fmt.Fprintf(&path, " A%.2f %.2f 0 %d %d %.2f %.2f", r, r, large, sweep, x, y)
+			mid = arc.pointAt(vertices[i], arc.centralAngle/2.0)
+		} else {
+			fmt.Fprintf(&path, " L%.2f %.2f", x, y)
+		}
+		labelX, labelY := px(mid)
+		fmt.Fprintf(&labels, `<text x="%.2f" y="%.2f" font-size="10">%d</text>`, labelX, labelY, i+1)
+	}
+	pobX, pobY := px(vertices[0])
+	var result bytes.Buffer
+	fmt.Fprintf(&result, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`, width, height, width, height)
+	fmt.Fprintf(&result, `<path d="%s" fill="none" stroke="black" stroke-width="1"/>`, path.String())
+	result.Write(labels.Bytes())
+	fmt.Fprintf(&result, `<circle cx="%.2f" cy="%.2f" r="3" fill="red"/>`, pobX, pobY)
+	fmt.Fprintf(&result, `<text x="%.2f" y="%.2f" font-size="12">POB</text>`, pobX+5, pobY-5)
+	result.WriteString(`</svg>`)
+	return result.Bytes(), nil
+}
+
+// geoJSONFeature is a GeoJSON feature holding a single polygon
+type geoJSONFeature struct {
+	Type       string            `json:"type"`
+	Geometry   geoJSONPolygon    `json:"geometry"`
+	Properties map[string]string `json:"properties"`
+}
+
+// geoJSONPolygon is a GeoJSON polygon geometry
+type geoJSONPolygon struct {
+	Type        string         `json:"type"`
+	Coordinates [][][2]float64 `json:"coordinates"`
+}
+
+// GeoJSON encodes the parcel boundary as a GeoJSON polygon feature carrying the kind, lot, block and subdivision of the
+// description as properties. The point of beginning is placed at origin and arcs are densified into chords. The ring
+// is wound counterclockwise as RFC 7946 requires of exterior rings.
+func (d *Description) GeoJSON(origin Point) ([]byte, error) {
+	ring, err := traverse([2]float64{origin.X, origin.Y}, d.boundary(), densifyChord)
+	if err != nil {
+		return nil, err
+	}
+	ring = windRing(closeRing(ring), false)
+	return json.Marshal(geoJSONFeature{
+		Type: "Feature",
+		Geometry: geoJSONPolygon{
+			Type:        "Polygon",
+			Coordinates: [][][2]float64{ring},
+		},
+		Properties: map[string]string{
+			"kind":        d.Kind,
+			"lot":         d.Lot,
+			"block":       d.Block,
+			"subdivision": d.Subdivision,
+		},
+	})
+}
+
+// DXF draws the parcel boundary as a single LWPOLYLINE in a minimal ASCII DXF. The point of beginning is placed at
+// origin. Arcs are drawn with bulge factors rather than being densified, so they are true curves in CAD. The polyline
+// is closed when the traverse closes.
+func (d *Description) DXF(origin Point) ([]byte, error) {
+	metes := flatten(d.boundary())
+	vertices, err := Coordinates([2]float64{origin.X, origin.Y}, metes)
+	if err != nil {
+		return nil, err
+	}
+	// the bulge of a vertex describes the segment which follows it and is positive for counterclockwise arcs
+	bulges := make([]float64, len(vertices))
+	for i, m := range metes {
+		if arc, ok := m.(*ArcMete); ok {
+			bulges[i] = -float64(arc.dir) * math.Tan(arc.centralAngle/4.0)
+		}
+	}
+	closed := 0
+	if ring := closeRing(vertices); len(ring) == len(vertices) && len(vertices) > 1 {
+		vertices, bulges, closed = vertices[:len(vertices)-1], bulges[:len(bulges)-1], 1
+	}
+	var result bytes.Buffer
+	result.WriteString("0\nSECTION\n2\nENTITIES\n")
+	result.WriteString("0\nLWPOLYLINE\n100\nAcDbEntity\n8\n0\n100\nAcDbPolyline\n")
+	fmt.Fprintf(&result, "90\n%d\n70\n%d\n", len(vertices), closed)
+	for i, v := range vertices {
+		fmt.Fprintf(&result, "10\n%s\n20\n%s\n", dxfFloat(v[0]), dxfFloat(v[1]))
+		if bulges[i] != 0.0 {
+			fmt.Fprintf(&result, "42\n%s\n", dxfFloat(bulges[i]))
+		}
+	}
+	result.WriteString("0\nENDSEC\n0\nEOF\n")
+	return result.Bytes(), nil
+}
+
+// dxfFloat formats a coordinate or bulge for a DXF group value
+func dxfFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', 6, 64)
+}
+
+// WKT encodes the parcel boundary as a Well-Known Text polygon, with arcs densified into chords of the default length.
+// The point of beginning is placed at origin.
+func (d *Description) WKT(origin Point) (string, error) {
+	return d.WKTWith(origin, densifyChord)
+}
+
+// WKTWith encodes the parcel boundary as a Well-Known Text polygon, with arcs densified into chords of at most
+// maxChord. The point of beginning is placed at origin and the ring follows the order of the traverse back to it.
+func (d *Description) WKTWith(origin Point, maxChord float64) (string, error) {
+	if maxChord <= 0.0 {
+		return "", fmt.Errorf("chord length must be positive")
+	}
+	ring, err := traverse([2]float64{origin.X, origin.Y}, d.boundary(), maxChord)
+	if err != nil {
+		return "", err
+	}
+	points := make([]string, 0, len(ring)+1)
+	for _, p := range closeRing(ring) {
+		points = append(points, wktFloat(p[0])+" "+wktFloat(p[1]))
+	}
+	return "POLYGON((" + strings.Join(points, ", ") + "))", nil
+}
+
+// wktFloat formats a coordinate for Well-Known Text, to the millionth without trailing zeros
+func wktFloat(f float64) string {
+	return strconv.FormatFloat(math.Round(f*1e6)/1e6, 'f', -1, 64)
+}
@@ -0,0 +1,238 @@
+package legal
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// FormatOptions controls the style in which a description is written. The zero value is the default style.
+type FormatOptions struct {
+	// CurveOrder is the order in which the radius, central angle and arc length of a curve are stated
+	CurveOrder CurveOrder
+	// FirstCallConnector replaces "THENCE" before the first boundary call, which follows the commencement tie when
+	// there is one. An empty connector joins the call directly to the point of beginning. Nil keeps "THENCE".
+	FirstCallConnector *string
+	// Chord is the phrasing used to state the chord of a curve, if it is stated at all
+	Chord ChordClause
+	// CallTransform, if set, rewrites the text of each call given its index among the metes
+	CallTransform func(index int, text string) string
+	// AreaVerb introduces the area of the parcel, ie "HAVING AN AREA OF". Empty uses "CONTAINING".
+	AreaVerb string
+	// CallDelimiter is inserted between consecutive calls so that tools can split a description back into its calls.
+	// It is empty for ordinary prose.
+	CallDelimiter string
+	// Symbols are the marks used for minutes and seconds in bearings and angles
+	Symbols AngleSymbols
+	// AreaUnits, if set, are the units in which the area is stated, converted from the unit of the description. The
+	// first unit is the primary statement and the rest follow in parentheses, ie 0.4047 HECTARES (1.00 ACRES).
+	AreaUnits []string
+	// RadiusPoint states the coordinates of the radius point of each curve. It requires the description to have start
+	// coordinates.
+	RadiusPoint bool
+	// Precision is the number of decimal places to which distances, areas and seconds are stated. Nil states distances
+	// and seconds to hundredths and areas as given.
+	Precision *Precision
+	// Courses is the way in which the direction of each straight course is stated
+	Courses CourseStyle
+	// StatePerimeter states the total length of the boundary after the area
+	StatePerimeter bool
+	// SixteenPoints states the direction and concavity of curves to the nearest point of a sixteen point compass,
+	// ie NORTH-NORTHEASTERLY, rather than the nearest of the eight cardinal and intercardinal directions
+	SixteenPoints bool
+	// CalculatedAreaTolerance, when positive, states the area calculated from the boundary after the stated area
+	// whenever the two differ by more than it, in the unit of the stated area
+	CalculatedAreaTolerance float64
+	// Case is the capitalization of the description
+	Case Case
+}
+
+// Precision gives the number of decimal places to which each kind of quantity is stated
+type Precision struct {
+	Distance int
+	Area     int
+	Seconds  int
+}
+
+// distancePlaces is the number of decimal places to which distances are stated
+func (opts FormatOptions) distancePlaces() int {
+	if opts.Precision == nil {
+		return 2
+	}
+	return opts.Precision.Distance
+}
+
+// secondsPlaces is the number of decimal places to which the seconds of bearings and angles are stated
+func (opts FormatOptions) secondsPlaces() int {
+	if opts.Precision == nil {
+		return 2
+	}
+	return opts.Precision.Seconds
+}
+
+// direction is the compass direction of an angle to the precision of the options
+func (opts FormatOptions) direction(angle float64) Direction {
+	if opts.SixteenPoints {
+		return DirectionFromAngle16(angle)
+	}
+	return DirectionFromAngle(angle)
+}
+
+// bearing describes a bearing in the style of the options
+func (opts FormatOptions) bearing(b *Bearing) string {
+	return b.describe(opts.Symbols, opts.secondsPlaces())
+}
+
+// Containing is the phrase introducing the area of the parcel
+func (opts FormatOptions) Containing() string {
+	if opts.AreaVerb == "" {
+		return "CONTAINING"
+	}
+	return opts.AreaVerb
+}
+
+// CurveOrder is an ordering of the radius, central angle and arc length of a curve
+type CurveOrder int
+
+// Curve orderings. By default the radius is stated in the preamble of a curve, followed by a call giving its central
+// angle and arc length. The other orderings state all three in the call itself.
+const (
+	RadiusInPreamble CurveOrder = iota
+	DeltaRadiusArc
+	RadiusDeltaArc
+)
+
+// ChordClause is a phrasing of the chord of a curve
+type ChordClause int
+
+// Chord phrasings. By default the chord of a curve is not stated.
+const (
+	NoChord        ChordClause = iota
+	SubtendedChord             // SAID CURVE BEING SUBTENDED BY A CHORD BEARING ..., A DISTANCE OF ...
+	ChordBears                 // THE CHORD OF WHICH BEARS ... A DISTANCE OF ...
+)
+
+// CourseStyle is a way of stating the direction of a straight course
+type CourseStyle int
+
+// Course styles. By default every course is given by its bearing. InteriorAngles gives each course after the first
+// boundary course by the interior angle it turns from the course before it, ie TURNING AN INTERIOR ANGLE OF 92°15'0.00"
+// TO THE RIGHT. Curves are described as usual.
+const (
+	Bearings CourseStyle = iota
+	InteriorAngles
+)
+
+// AngleSymbols is a set of marks for the degrees, minutes and seconds of an angle
+type AngleSymbols int
+
+// Angle symbols. By default minutes and seconds are marked with the ASCII apostrophe and quotation mark, while
+// PrimeSymbols uses the typographically correct prime (U+2032) and double prime (U+2033). SpelledSymbols avoids
+// symbols altogether for word processors which mangle them, ie NORTH 10 DEG 15 MIN 30.00 SEC WEST.
+const (
+	ASCIISymbols AngleSymbols = iota
+	PrimeSymbols
+	SpelledSymbols
+)
+
+// Case is the capitalization of a description
+type Case int
+
+// Cases. By default a description is written in capitals. TitleCase capitalizes each word other than short
+// connecting words such as OF and THE, and SentenceCase capitalizes only the start of each sentence, proper nouns such
+// as the subdivision, city and county, and the directions of bearings, ie "A part of Lot 11, Block 15, Witt's
+// Addition to the City of North Little Rock". Names given in capitals are capitalized as words, while names given in
+// mixed case, ie "McArthur Park", are written exactly as given.
+const (
+	UpperCase Case = iota
+	TitleCase
+	SentenceCase
+)
+
+// marks are the degree, minute and second symbols
+func (s AngleSymbols) marks() (degree, minute, second string) {
+	switch s {
+	case PrimeSymbols:
+		return "°", "′", "″"
+	case SpelledSymbols:
+		return " DEG ", " MIN ", " SEC"
+	}
+	return "°", "'", "\""
+}
+
+// areaConversions give the size in square meters of each unit of area and the decimal places to which it is stated.
+// Areas in square feet are grouped into thousands. They are the one table through which areas are converted; the
+// square of any other unit of length is converted by its length in meters.
+var areaConversions = map[string]struct {
+	squareMeters float64
+	places       int
+}{
+	"SQUARE FEET":   {0.09290304, 0},
+	"SQUARE FOOT":   {0.09290304, 0},
+	"SQUARE METERS": {1.0, 2},
+	"SQUARE METRES": {1.0, 2},
+	"ACRES":         {4046.8564224, 2},
+	"ACRE":          {4046.8564224, 2},
+	"HECTARES":      {10000.0, 4},
+	"HECTARE":       {10000.0, 4},
+}
+
+// areaSquareMeters is the size in square meters of a unit of area, which is either one of the areaConversions or the
+// square of a unit of length, ie SQUARE CHAINS
+func areaSquareMeters(unit string) (float64, bool) {
+	unit = strings.ToUpper(strings.TrimSpace(unit))
+	if c, ok := areaConversions[unit]; ok {
+		return c.squareMeters, true
+	}
+	if length, ok := UnitFromString(strings.TrimPrefix(unit, "SQUARE ")); ok && strings.HasPrefix(unit, "SQUARE ") {
+		return unitMeters[length] * unitMeters[length], true
+	}
+	return 0.0, false
+}
+
+// toAreaUnit converts an area given in one unit to another
+func toAreaUnit(area float64, from, to string) (float64, error) {
+	src, ok := areaSquareMeters(from)
+	if !ok {
+		return 0.0, fmt.Errorf("cannot convert area from unrecognized unit %q", from)
+	}
+	dst, ok := areaSquareMeters(to)
+	if !ok {
+		return 0.0, fmt.Errorf("cannot convert area to unrecognized unit %q", to)
+	}
+	return area * src / dst, nil
+}
+
+// convertArea states an area given in one unit in another unit, to the given number of decimal places or, when it is
+// negative, to the usual places of the unit. Units usually stated in whole numbers are grouped into thousands.
+func convertArea(area float64, from, to string, places int) (string, error) {
+	converted, err := toAreaUnit(area, from, to)
+	if err != nil {
+		return "", err
+	}
+	unit := strings.ToUpper(strings.TrimSpace(to))
+	usual, ok := areaConversions[unit]
+	if !ok {
+		usual.places = 2
+	}
+	if places < 0 {
+		places = usual.places
+	}
+	value := strconv.FormatFloat(converted, 'f', places, 64)
+	if usual.places == 0 {
+		value = groupThousands(value)
+	}
+	return value + " " + unit, nil
+}
+
+// groupThousands separates the whole digits of a number into groups of three with commas
+func groupThousands(digits string) string {
+	whole := strings.IndexByte(digits, '.')
+	if whole == -1 {
+		whole = len(digits)
+	}
+	for i := whole - 3; i > 0; i -= 3 {
+		digits = digits[:i] + "," + digits[i:]
+	}
+	return digits
+}
@@ -0,0 +1,30 @@
+package legal
+
+import (
+	"math"
+)
+
+// earthRadius is the mean radius of the earth in meters
+const earthRadius = 6371008.8
+
+// LatLon is a geographic position in decimal degrees
+type LatLon struct {
+	Lat float64
+	Lon float64
+}
+
+// GeodeticInverse computes the initial bearing and distance in meters from one geographic position to another. The
+// earth is treated as a sphere, using the haversine formula for distance. This is accurate to a fraction of a percent,
+// which is acceptable for the short lines of a small parcel but not for geodetic control.
+func GeodeticInverse(from, to LatLon) (Bearing, float64) {
+	phi1, phi2 := from.Lat*math.Pi/180.0, to.Lat*math.Pi/180.0
+	dPhi := phi2 - phi1
+	dLambda := (to.Lon - from.Lon) * math.Pi / 180.0
+	a := math.Pow(math.Sin(dPhi/2.0), 2) + math.Cos(phi1)*math.Cos(phi2)*math.Pow(math.Sin(dLambda/2.0), 2)
+	distance := 2.0 * earthRadius * math.Asin(math.Sqrt(a))
+	y := math.Sin(dLambda) * math.Cos(phi2)
+	x := math.Cos(phi1)*math.Sin(phi2) - math.Sin(phi1)*math.Cos(phi2)*math.Cos(dLambda)
+	var b Bearing
+	b.FromAngle(math.Atan2(y, x))
+	return b, distance
+}
@@ -0,0 +1,654 @@
+package legal
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// densifyChord is the default maximum chord length used when approximating arcs with straight segments
+const densifyChord = 1.0
+
+// Endpoint is the coordinate reached by travelling along the mete from start. Coordinates are (easting, northing).
+func (m *LinearMete) Endpoint(start [2]float64) [2]float64 {
+	return [2]float64{
+		start[0] + m.distance*math.Sin(m.bearing),
+		start[1] + m.distance*math.Cos(m.bearing),
+	}
+}
+
+// Center is the radius point of the arc when travel begins at start
+func (am *ArcMete) Center(start [2]float64) [2]float64 {
+	toCenter := am.tangent + float64(am.dir)*math.Pi/2.0
+	return [2]float64{
+		start[0] + am.radius*math.Sin(toCenter),
+		start[1] + am.radius*math.Cos(toCenter),
+	}
+}
+
+// pointAt is the coordinate on the arc after sweeping through angle from start
+func (am *ArcMete) pointAt(start [2]float64, angle float64) [2]float64 {
+	center := am.Center(start)
+	radial := am.tangent - float64(am.dir)*math.Pi/2.0 + float64(am.dir)*angle
+	return [2]float64{
+		center[0] + am.radius*math.Sin(radial),
+		center[1] + am.radius*math.Cos(radial),
+	}
+}
+
+// Endpoint is the coordinate reached by travelling along the arc from start
+func (am *ArcMete) Endpoint(start [2]float64) [2]float64 {
+	return am.pointAt(start, am.centralAngle)
+}
+
+// Densify approximates the arc with chords no longer than maxChord. The start is omitted and the endpoint is included.
+func (am *ArcMete) Densify(start [2]float64, maxChord float64) [][2]float64 {
+	steps := 1
+	if maxChord > 0.0 {
+		steps = int(math.Ceil(am.ArcLength() / maxChord))
+	}
+	if steps < 1 {
+		steps = 1
+	}
+	points := make([][2]float64, 0, steps)
+	for i := 1; i <= steps; i++ {
+		points = append(points, am.pointAt(start, am.centralAngle*float64(i)/float64(steps)))
+	}
+	return points
+}
+
+// Inverse computes the bearing and distance from one (easting, northing) coordinate to another
+func Inverse(from, to [2]float64) (Bearing, float64) {
+	dx, dy := to[0]-from[0], to[1]-from[1]
+	var b Bearing
+	b.FromAngle(math.Atan2(dx, dy))
+	return b, math.Hypot(dx, dy)
+}
+
+// BasisOfBearing states the line between two control points, given as (easting, northing) coordinates, as the basis
+// of bearing for a description.
+func BasisOfBearing(from, to [2]float64, fromLabel, toLabel string) string {
+	b, _ := Inverse(from, to)
+	return fmt.Sprintf("BASIS OF BEARING: THE LINE BETWEEN %s AND %s, TAKEN AS %s.", strings.ToUpper(fromLabel), strings.ToUpper(toLabel), b.Describe())
+}
+
+// Coordinates returns the vertices of a traverse beginning at start, including the start itself. It is the primitive
+// on which the geometry of this package is built, for walking any list of metes. Callers holding a Description should
+// use its Coordinates method instead, which knows which of its metes bound the parcel.
+func Coordinates(start [2]float64, metes []Mete) ([][2]float64, error) {
+	return traverse(start, metes, 0.0)
+}
+
+// Point is a vertex of a traverse in the unit of its metes
+type Point struct {
+	X, Y float64
+}
+
+// Coordinates walks the boundary of the description from the point of beginning, at an assumed origin of (0, 0), and
+// returns the coordinates of each vertex, beginning with the origin. X is the easting and Y the northing. Any
+// commencement tie leads to the origin and is not walked. It is the function Coordinates applied to the boundary, and
+// is the way to compute the vertices of a description.
+func (d *Description) Coordinates() ([]Point, error) {
+	vertices, err := Coordinates([2]float64{0.0, 0.0}, d.boundary())
+	if err != nil {
+		return nil, err
+	}
+	points := make([]Point, len(vertices))
+	for i, v := range vertices {
+		points[i] = Point{X: v[0], Y: v[1]}
+	}
+	return points, nil
+}
+
+// DescriptionFromPoints derives a description from the vertices of a parcel in order, such as a point file from GPS.
+// Each course is the inverse between consecutive points, and a final course closes back to the first point unless the
+// list already ends there. Curves can not be recognized from vertices, so every course is straight. The point of
+// beginning is placed at the first point and the area is computed in square units of the points.
+func DescriptionFromPoints(pts []Point, unit string) (*Description, error) {
+	if len(pts) > 1 && pts[len(pts)-1] == pts[0] {
+		pts = pts[:len(pts)-1]
+	}
+	if len(pts) < 3 {
+		return nil, fmt.Errorf("a parcel needs at least 3 points, got %d", len(pts))
+	}
+	unit = strings.ToUpper(unit)
+	metes := make([]Mete, len(pts))
+	for i, p := range pts {
+		next := pts[(i+1)%len(pts)]
+		b, dist := Inverse([2]float64{p.X, p.Y}, [2]float64{next.X, next.Y})
+		if dist == 0.0 {
+			return nil, fmt.Errorf("points %d and %d coincide", i+1, (i+1)%len(pts)+1)
+		}
+		mete := NewLinearMete(b.ToAngle(), dist, unit)
+		metes[i] = &mete
+	}
+	area, err := Area(metes)
+	if err != nil {
+		return nil, err
+	}
+	start := [2]float64{pts[0].X, pts[0].Y}
+	return &Description{
+		Metes:            metes,
+		Area:             area,
+		Unit:             "SQUARE " + unit,
+		StartCoordinates: &start,
+	}, nil
+}
+
+// traverse walks the metes from start. Arcs are densified into chords of at most maxChord when maxChord is positive,
+// otherwise only their endpoints are returned.
+func traverse(start [2]float64, metes []Mete, maxChord float64) ([][2]float64, error) {
+	metes, _, err := toCommonUnit(metes)
+	if err != nil {
+		return nil, err
+	}
+	points := [][2]float64{start}
+	current := start
+	for i, m := range metes {
+		switch mete := m.(type) {
+		case *LinearMete:
+			current = mete.Endpoint(current)
+			points = append(points, current)
+		case *ArcMete:
+			if maxChord > 0.0 {
+				points = append(points, mete.Densify(current, maxChord)...)
+			} else {
+				points = append(points, mete.Endpoint(current))
+			}
+			current = points[len(points)-1]
+		default:
+			return nil, fmt.Errorf("mete %d: unsupported mete type %T", i+1, m)
+		}
+	}
+	return points, nil
+}
+
+// closure is the vector from the end of a traverse back to its start
+func closure(start [2]float64, metes []Mete) (dx, dy float64, err error) {
+	points, err := Coordinates(start, metes)
+	if err != nil {
+		return 0.0, 0.0, err
+	}
+	end := points[len(points)-1]
+	return start[0] - end[0], start[1] - end[1], nil
+}
+
+// Closure reports the misclosure of the boundary of the description, as the easting and northing from the end of its
+// final call back to the point of beginning, the linear misclosure and the precision ratio of the perimeter to the
+// linear misclosure. The precision is zero when the traverse closes exactly.
+func (d *Description) Closure() (dx, dy, linear float64, precision float64, err error) {
+	metes := d.boundary()
+	dx, dy, err = closure([2]float64{0.0, 0.0}, metes)
+	if err != nil {
+		return 0.0, 0.0, 0.0, 0.0, err
+	}
+	perimeter, err := Perimeter(metes)
+	if err != nil {
+		return 0.0, 0.0, 0.0, 0.0, err
+	}
+	if perimeter == 0.0 {
+		return 0.0, 0.0, 0.0, 0.0, fmt.Errorf("boundary has no length")
+	}
+	linear = math.Hypot(dx, dy)
+	if linear > 0.0 {
+		precision = perimeter / linear
+	}
+	return dx, dy, linear, precision, nil
+}
+
+// ClosureBearing is the direction and length of the error of closure of a traverse, the vector from its start to
+// where its final call actually ends. The length is NaN if the metes can not be traversed.
+func ClosureBearing(start [2]float64, metes []Mete) (Bearing, float64) {
+	dx, dy, err := closure(start, metes)
+	if err != nil {
+		return Bearing{}, math.NaN()
+	}
+	return Inverse(start, [2]float64{start[0] - dx, start[1] - dy})
+}
+
+// meteUnit is the unit of length of a mete, if it is known
+func meteUnit(m Mete) string {
+	switch mete := m.(type) {
+	case *LinearMete:
+		return mete.unit
+	case *ArcMete:
+		return mete.unit
+	case *MeanderSegment:
+		if len(mete.Metes) > 0 {
+			return meteUnit(mete.Metes[len(mete.Metes)-1])
+		}
+	}
+	return ""
+}
+
+// normalizeUnit reduces the common spellings and abbreviations of a unit of length to a single name
+func normalizeUnit(unit string) string {
+	u := strings.ToUpper(strings.TrimSpace(unit))
+	switch u {
+	case "FT", "FT.", "FOOT", "FEET", "'":
+		return "FEET"
+	case "M", "M.", "METER", "METERS", "METRE", "METRES":
+		return "METERS"
+	case "USFT", "US FT", "SFT", "SURVEY FOOT", "SURVEY FEET", "US SURVEY FOOT", "US SURVEY FEET":
+		return "US SURVEY FEET"
+	case "CH", "CHAIN", "CHAINS":
+		return "CHAINS"
+	case "RD", "ROD", "RODS", "POLE", "POLES", "PERCH", "PERCHES":
+		return "RODS"
+	case "VARA", "VARAS", "VRS":
+		return "VARAS"
+	}
+	return u
+}
+
+// commonUnit is the unit of length in which the metes are computed, which is the unit of the first mete to state one.
+// It is an error for the metes to differ in a unit which can not be converted.
+func commonUnit(metes []Mete) (string, error) {
+	_, unit, err := toCommonUnit(metes)
+	return unit, err
+}
+
+// Area is the area enclosed by a closed traverse in square units of its metes
+func Area(metes []Mete) (float64, error) {
+	area, err := signedArea([2]float64{0.0, 0.0}, metes)
+	return math.Abs(area), err
+}
+
+// AreaCheck compares the stated area of the description with the area enclosed by its boundary, including the segments
+// cut off by its curves, or for a centerline with the width of the strip times the length of the centerline. The
+// computed area is converted to the unit of the stated area, or left in square units of the metes when no unit is
+// stated. The difference is the stated area less the computed area and is ok when it is within tolerance, in the
+// same unit.
+func (d *Description) AreaCheck(tolerance float64) (computed float64, diff float64, ok bool, err error) {
+	if d.Centerline {
+		var unit string
+		computed, unit, err = d.centerlineArea()
+		if err == nil {
+			computed, err = d.inStatedUnit(computed, unit)
+		}
+	} else {
+		computed, err = d.enclosed(d.boundary())
+	}
+	if err != nil {
+		return 0.0, 0.0, false, err
+	}
+	diff = d.Area - computed
+	return computed, diff, math.Abs(diff) <= tolerance, nil
+}
+
+// Perimeter is the total length of a traverse along its lines and arcs
+func Perimeter(metes []Mete) (float64, error) {
+	metes, _, err := toCommonUnit(metes)
+	if err != nil {
+		return 0.0, err
+	}
+	total := 0.0
+	for i, m := range metes {
+		switch mete := m.(type) {
+		case *LinearMete:
+			total += mete.distance
+		case *ArcMete:
+			total += mete.ArcLength()
+		default:
+			return 0.0, fmt.Errorf("mete %d: unsupported mete type %T", i+1, m)
+		}
+	}
+	return total, nil
+}
+
+// closeRing ensures the last point of a ring is exactly its first point
+func closeRing(ring [][2]float64) [][2]float64 {
+	const epsilon = 1e-6
+	first, last := ring[0], ring[len(ring)-1]
+	if len(ring) > 1 && math.Abs(first[0]-last[0]) < epsilon && math.Abs(first[1]-last[1]) < epsilon {
+		ring[len(ring)-1] = first
+		return ring
+	}
+	return append(ring, first)
+}
+
+// boundary returns the metes which enclose the parcel, omitting the commencement tie when there is one.
+func (d *Description) boundary() []Mete {
+	if d.Commencement && len(d.Metes) > 0 {
+		return d.Metes[1:]
+	}
+	return d.Metes
+}
+
+// SplitAtStation divides a traverse at a distance measured along it from its start. The call containing the station
+// is subdivided, so that before ends and after begins exactly at the station. Arcs are divided into two arcs of the
+// same radius, the second beginning tangent to the end of the first. Meanders are split into their individual courses.
+func SplitAtStation(metes []Mete, station float64) (before, after []Mete, err error) {
+	if station < 0.0 {
+		return nil, nil, fmt.Errorf("station %.2f is before the start of the traverse", station)
+	}
+	metes = flatten(metes)
+	travelled := 0.0
+	for i, m := range metes {
+		var length float64
+		switch mete := m.(type) {
+		case *LinearMete:
+			length = mete.distance
+		case *ArcMete:
+			length = mete.ArcLength()
+		default:
+			return nil, nil, fmt.Errorf("mete %d: unsupported mete type %T", i+1, m)
+		}
+		if station > travelled+length {
+			travelled += length
+			continue
+		}
+		before = append(before, metes[:i]...)
+		offset := station - travelled
+		switch {
+		case offset == 0.0:
+			after = append(after, metes[i:]...)
+			return before, after, nil
+		case offset == length:
+			before = append(before, m)
+			after = append(after, metes[i+1:]...)
+			return before, after, nil
+		}
+		switch mete := m.(type) {
+		case *LinearMete:
+			first, second := *mete, *mete
+			first.distance = offset
+			second.distance = length - offset
+			before = append(before, &first)
+			after = append(after, &second)
+		case *ArcMete:
+			first, second := *mete, *mete
+			first.centralAngle = offset / mete.radius
+			second.centralAngle = mete.centralAngle - first.centralAngle
+			second.tangent = mete.tangent + float64(mete.dir)*first.centralAngle
+			before = append(before, &first)
+			after = append(after, &second)
+		}
+		after = append(after, metes[i+1:]...)
+		return before, after, nil
+	}
+	return nil, nil, fmt.Errorf("station %.2f is beyond the end of the traverse at %.2f", station, travelled)
+}
+
+// signedArea is the area enclosed by a closed traverse, positive when the traverse runs counterclockwise. Each arc
+// contributes the circular segment between its chord and the curve.
+func signedArea(start [2]float64, metes []Mete) (float64, error) {
+	metes, _, err := toCommonUnit(metes)
+	if err != nil {
+		return 0.0, err
+	}
+	points, err := Coordinates(start, metes)
+	if err != nil {
+		return 0.0, err
+	}
+	sum := 0.0
+	for i := range points {
+		j := (i + 1) % len(points) // any misclosure is treated as a straight closing line
+		sum += points[i][0]*points[j][1] - points[j][0]*points[i][1]
+	}
+	area := sum / 2.0
+	for _, m := range metes {
+		if am, ok := m.(*ArcMete); ok {
+			// the curve lies outside the chord when it turns the same way as a counterclockwise traverse
+			segment := am.radius * am.radius / 2.0 * (am.centralAngle - math.Sin(am.centralAngle))
+			area -= float64(am.dir) * segment
+		}
+	}
+	return area, nil
+}
+
+// AdjustToArea moves the final vertex of a closed traverse along the direction of the call leading to it, so that the
+// traverse encloses targetArea. The closing call is recomputed from the moved vertex back to the start. Both the
+// final and closing calls must be straight lines, and the original metes are left unchanged.
+func AdjustToArea(metes []Mete, targetArea float64) ([]Mete, error) {
+	const tolerance = 1e-6
+	metes = flatten(metes)
+	if len(metes) < 3 {
+		return nil, fmt.Errorf("at least 3 metes are required to enclose an area, got %d", len(metes))
+	}
+	final, ok := metes[len(metes)-2].(*LinearMete)
+	if !ok {
+		return nil, fmt.Errorf("the final call must be a straight line to be adjusted, got %T", metes[len(metes)-2])
+	}
+	closing, ok := metes[len(metes)-1].(*LinearMete)
+	if !ok {
+		return nil, fmt.Errorf("the closing call must be a straight line to be adjusted, got %T", metes[len(metes)-1])
+	}
+	origin := [2]float64{0.0, 0.0}
+	// with its neighbours fixed, the enclosed area is linear in the position of the final vertex
+	areaWith := func(distance float64) (float64, []Mete, error) {
+		adjusted := make([]Mete, len(metes))
+		copy(adjusted, metes)
+		moved := *final
+		moved.distance = distance
+		adjusted[len(adjusted)-2] = &moved
+		points, err := Coordinates(origin, adjusted[:len(adjusted)-1])
+		if err != nil {
+			return 0.0, nil, err
+		}
+		end := points[len(points)-1]
+		closed := *closing
+		closed.bearing = math.Atan2(origin[0]-end[0], origin[1]-end[1])
+		closed.distance = math.Hypot(origin[0]-end[0], origin[1]-end[1])
+		adjusted[len(adjusted)-1] = &closed
+		area, err := signedArea(origin, adjusted)
+		return area, adjusted, err
+	}
+	current, _, err := areaWith(final.distance)
+	if err != nil {
+		return nil, err
+	}
+	stepped, _, err := areaWith(final.distance + 1.0)
+	if err != nil {
+		return nil, err
+	}
+	rate := stepped - current
+	if math.Abs(rate) < tolerance {
+		return nil, fmt.Errorf("moving the final vertex along its call does not change the area")
+	}
+	target := targetArea
+	if current < 0.0 {
+		target = -targetArea
+	}
+	distance := final.distance + (target-current)/rate
+	if distance <= 0.0 {
+		return nil, fmt.Errorf("an area of %.2f can not be reached by moving the final vertex", targetArea)
+	}
+	area, adjusted, err := areaWith(distance)
+	if err != nil {
+		return nil, err
+	}
+	if math.Abs(math.Abs(area)-targetArea) > tolerance*math.Max(1.0, targetArea) {
+		return nil, fmt.Errorf("adjusted area %.4f does not match the target %.4f", math.Abs(area), targetArea)
+	}
+	return adjusted, nil
+}
+
+// boundaryTolerance is the distance within which a point is considered to lie on a boundary
+const boundaryTolerance = 1e-6
+
+// Contains reports whether a point lies within the parcel bounded by the metes when the traverse begins at start.
+// Arcs are densified into chords and the ring is closed back to start. Points on the boundary are always contained,
+// and a boundary which cannot be traversed contains nothing.
+func Contains(start [2]float64, metes []Mete, point [2]float64) bool {
+	ring, err := traverse(start, metes, densifyChord)
+	if err != nil || len(ring) < 3 {
+		return false
+	}
+	ring = closeRing(ring)
+	inside := false
+	for i := 1; i < len(ring); i++ {
+		a, b := ring[i-1], ring[i]
+		if onSegment(a, b, point) {
+			return true
+		}
+		// count crossings of a ray cast east from the point
+		if (a[1] > point[1]) != (b[1] > point[1]) {
+			x := a[0] + (point[1]-a[1])*(b[0]-a[0])/(b[1]-a[1])
+			if x > point[0] {
+				inside = !inside
+			}
+		}
+	}
+	return inside
+}
+
+// onSegment reports whether point p lies on the segment from a to b
+func onSegment(a, b, p [2]float64) bool {
+	dx, dy := b[0]-a[0], b[1]-a[1]
+	length := math.Hypot(dx, dy)
+	if length == 0.0 {
+		return math.Hypot(p[0]-a[0], p[1]-a[1]) <= boundaryTolerance
+	}
+	cross := (dx*(p[1]-a[1]) - dy*(p[0]-a[0])) / length
+	if math.Abs(cross) > boundaryTolerance {
+		return false
+	}
+	along := (dx*(p[0]-a[0]) + dy*(p[1]-a[1])) / length
+	return along >= -boundaryTolerance && along <= length+boundaryTolerance
+}
+
+// Reverse is the description written in the opposite direction of travel. The boundary calls are taken in reverse
+// order with lines turned about and curves swept the other way, so the parcel is unchanged. Any commencement tie still
+// leads to the point of beginning. When the boundary does not close, the reversed description begins at the end of the
+// final call, which can only be described by coordinates.
+func (d *Description) Reverse() (*Description, error) {
+	metes := d.boundary()
+	dx, dy, err := closure([2]float64{0.0, 0.0}, metes)
+	if err != nil {
+		return nil, err
+	}
+	reversed := *d
+	closed := math.Hypot(dx, dy) <= boundaryTolerance
+	if !closed {
+		if d.StartCoordinates == nil {
+			return nil, fmt.Errorf("boundary does not close, so its reverse can only begin at coordinates")
+		}
+		end := [2]float64{d.StartCoordinates[0] - dx, d.StartCoordinates[1] - dy}
+		reversed.StartCoordinates = &end
+	}
+	reversed.Metes = make([]Mete, 0, len(d.Metes))
+	if len(metes) < len(d.Metes) {
+		reversed.Metes = append(reversed.Metes, d.Metes[0])
+	}
+	backward, err := reverseMetes(metes)
+	if err != nil {
+		return nil, err
+	}
+	moveMonuments(metes, backward, closed)
+	reversed.Metes = append(reversed.Metes, backward...)
+	return &reversed, nil
+}
+
+// moveMonuments names each monument of the forward calls at the end of the backward call which now reaches it. A
+// backward call ends where the forward call before its counterpart ended, and the last ends at the start of the
+// forward traverse, whose monument is known only when the traverse closes on it.
+func moveMonuments(forward, backward []Mete, closed bool) {
+	ahead, behind := flatten(forward), flatten(backward)
+	n := len(ahead)
+	for j, m := range behind {
+		monument := ""
+		if i := n - 2 - j; i >= 0 {
+			monument = endMonument(ahead[i])
+		} else if closed {
+			monument = endMonument(ahead[n-1])
+		}
+		switch mete := m.(type) {
+		case *LinearMete:
+			mete.EndMonument = monument
+		case *ArcMete:
+			mete.EndMonument = monument
+		}
+	}
+}
+
+// reverseMetes traverses the metes backward, from the end of the last to the start of the first. Each call keeps its
+// own monument, which Reverse moves to the vertex where it was found.
+func reverseMetes(metes []Mete) ([]Mete, error) {
+	reversed := make([]Mete, len(metes))
+	for i, m := range metes {
+		var r Mete
+		switch mete := m.(type) {
+		case *LinearMete:
+			back := *mete
+			back.bearing = normalizeAngle(mete.bearing + math.Pi)
+			r = &back
+		case *ArcMete:
+			back := *mete
+			back.tangent = normalizeAngle(endTangent(mete) + math.Pi)
+			back.dir = -mete.dir
+			r = &back
+		case *MeanderSegment:
+			inner, err := reverseMetes(mete.Metes)
+			if err != nil {
+				return nil, err
+			}
+			r = NewMeanderSegment(mete.Feature, inner...)
+		default:
+			return nil, fmt.Errorf("mete %d: unsupported mete type %T", i+1, m)
+		}
+		reversed[len(metes)-1-i] = r
+	}
+	return reversed, nil
+}
+
+// SegmentText is a call of a description broken into its parts, for building course tables and the like
+type SegmentText struct {
+	// Preamble describes the point reached by the previous call, ie "THE BEGINNING OF A CURVE CONCAVE NORTHERLY". It is
+	// empty for the first call.
+	Preamble string
+	// Connector introduces the call, ie "THENCE"
+	Connector string
+	// Body is the text of the call
+	Body string
+	// Bearing is the bearing of a line or the chord bearing of a curve
+	Bearing Bearing
+	// Distance is the length of a line or the arc length of a curve
+	Distance float64
+	Unit     string
+	// End is the coordinate reached by the call, placing the point of beginning at the start coordinates of the
+	// description or else the origin
+	End Point
+}
+
+// Segments breaks the description into its calls with the text of each and the course it computes to
+func (d *Description) Segments() ([]SegmentText, error) {
+	beginning := [2]float64{0.0, 0.0}
+	if d.StartCoordinates != nil {
+		beginning = *d.StartCoordinates
+	}
+	start, err := d.commencementPoint(beginning)
+	if err != nil {
+		return nil, err
+	}
+	segments := make([]SegmentText, len(d.Metes))
+	for i, m := range d.Metes {
+		points, err := Coordinates(start, []Mete{m})
+		if err != nil {
+			return nil, fmt.Errorf("mete %d: %v", i+1, err)
+		}
+		end := points[len(points)-1]
+		body, err := d.Call(i)
+		if err != nil {
+			return nil, err
+		}
+		seg := SegmentText{Connector: d.Connector(i), Body: body, Unit: meteUnit(m), End: Point{X: end[0], Y: end[1]}}
+		if i > 0 {
+			seg.Preamble = d.Preamble(i, d.PreviousTangent(i))
+		}
+		switch mete := m.(type) {
+		case *LinearMete:
+			seg.Bearing.FromAngle(mete.bearing)
+			seg.Distance = mete.distance
+		case *ArcMete:
+			seg.Bearing.FromAngle(mete.ChordAngle())
+			seg.Distance = mete.ArcLength()
+		default:
+			seg.Bearing, _ = Inverse(start, end)
+			seg.Distance, _ = Perimeter([]Mete{m})
+		}
+		segments[i] = seg
+		start = end
+	}
+	return segments, nil
+}
@@ -0,0 +1,77 @@
+package legal
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// geoJSON is the subset of a GeoJSON object needed to find a polygon in a feature collection, feature or geometry
+type geoJSON struct {
+	Type        string          `json:"type"`
+	Features    []geoJSON       `json:"features"`
+	Geometry    *geoJSON        `json:"geometry"`
+	Coordinates json.RawMessage `json:"coordinates"`
+}
+
+// polygon finds the outer ring of the first polygon in a GeoJSON object
+func (g *geoJSON) polygon() ([][]float64, bool) {
+	switch g.Type {
+	case "FeatureCollection":
+		for i := range g.Features {
+			if ring, ok := g.Features[i].polygon(); ok {
+				return ring, true
+			}
+		}
+	case "Feature":
+		if g.Geometry != nil {
+			return g.Geometry.polygon()
+		}
+	case "Polygon":
+		var rings [][][]float64
+		if err := json.Unmarshal(g.Coordinates, &rings); err != nil || len(rings) == 0 {
+			return nil, false
+		}
+		return rings[0], true
+	}
+	return nil, false
+}
+
+// FromGeoJSON reads the outer ring of the first polygon in a GeoJSON feature collection, feature or geometry. Each edge
+// of the ring becomes a linear mete in feet, and the first vertex of the ring is returned as the start. Coordinates are
+// presumed to be planar (easting, northing) in feet. Holes are ignored, and since polygons are made of straight
+// segments any arcs the parcel had when it was drawn are lost.
+func FromGeoJSON(r io.Reader) ([]Mete, [2]float64, error) {
+	var g geoJSON
+	err := json.NewDecoder(r).Decode(&g)
+	if err != nil {
+		return nil, [2]float64{}, err
+	}
+	ring, ok := g.polygon()
+	if !ok {
+		return nil, [2]float64{}, fmt.Errorf("no polygon found in GeoJSON")
+	}
+	if len(ring) < 3 {
+		return nil, [2]float64{}, fmt.Errorf("polygon ring has %d positions, at least 3 are required", len(ring))
+	}
+	points := make([][2]float64, len(ring))
+	for i, pos := range ring {
+		if len(pos) < 2 {
+			return nil, [2]float64{}, fmt.Errorf("position %d has %d coordinates, at least 2 are required", i+1, len(pos))
+		}
+		points[i] = [2]float64{pos[0], pos[1]}
+	}
+	if points[0] != points[len(points)-1] {
+		points = append(points, points[0])
+	}
+	var metes []Mete
+	for i := 0; i < len(points)-1; i++ {
+		b, dist := Inverse(points[i], points[i+1])
+		if dist == 0.0 {
+			continue
+		}
+		mete := NewLinearMete(b.ToAngle(), dist, "feet")
+		metes = append(metes, &mete)
+	}
+	return metes, points[0], nil
+}
@@ -0,0 +1,364 @@
+package legal
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// landXMLDoc is the subset of a LandXML document needed to read the boundary of a parcel
+type landXMLDoc struct {
+	Units struct {
+		Imperial landXMLUnits `xml:"Imperial"`
+		Metric   landXMLUnits `xml:"Metric"`
+	} `xml:"Units"`
+	Parcels []landXMLParcel `xml:"Parcels>Parcel"`
+}
+
+// landXMLUnits are the units declared by a LandXML document
+type landXMLUnits struct {
+	LinearUnit string `xml:"linearUnit,attr"`
+	AreaUnit   string `xml:"areaUnit,attr,omitempty"`
+}
+
+// landXMLParcel is a parcel and the elements of its boundary in order
+type landXMLParcel struct {
+	Name      string `xml:"name,attr"`
+	Area      string `xml:"area,attr"`
+	CoordGeom struct {
+		Elements []landXMLGeom `xml:",any"`
+	} `xml:"CoordGeom"`
+}
+
+// landXMLGeom is a Line or Curve element of a parcel boundary. Points are given as "northing easting".
+type landXMLGeom struct {
+	XMLName  xml.Name
+	Dir      string `xml:"dir,attr"`
+	DirStart string `xml:"dirStart,attr"`
+	Length   string `xml:"length,attr"`
+	Radius   string `xml:"radius,attr"`
+	Delta    string `xml:"delta,attr"`
+	Rot      string `xml:"rot,attr"`
+	Start    string `xml:"Start"`
+	Center   string `xml:"Center"`
+	End      string `xml:"End"`
+}
+
+// landXMLLinearUnits and landXMLAreaUnits name the LandXML units as they are written in a description
+var (
+	landXMLLinearUnits = map[string]string{
+		"foot":         "FEET",
+		"USSurveyFoot": "US SURVEY FEET",
+		"meter":        "METERS",
+	}
+	landXMLAreaUnits = map[string]string{
+		"squareFoot":  "SQUARE FEET",
+		"acre":        "ACRES",
+		"squareMeter": "SQUARE METERS",
+		"hectare":     "HECTARES",
+	}
+)
+
+// ParseLandXML reads the boundary of the first parcel in a LandXML document. Lines take their bearing and length from
+// their start and end points, or failing those from their dir and length attributes. Curves are read from their start,
+// center and end points, or failing those from their radius, delta and dirStart attributes along with their rot.
+// Directions are read as azimuths in decimal degrees. The description begins at the start of the first element.
+func ParseLandXML(r io.Reader) (*Description, error) {
+	var doc landXMLDoc
+	err := xml.NewDecoder(r).Decode(&doc)
+	if err != nil {
+		return nil, err
+	}
+	if len(doc.Parcels) == 0 {
+		return nil, fmt.Errorf("no parcels in LandXML")
+	}
+	units := doc.Units.Imperial
+	if units.LinearUnit == "" {
+		units = doc.Units.Metric
+	}
+	unit, ok := landXMLLinearUnits[units.LinearUnit]
+	if !ok {
+		unit = "FEET"
+	}
+	parcel := doc.Parcels[0]
+	d := &Description{Unit: landXMLAreaUnits[units.AreaUnit]}
+	if parcel.Area != "" {
+		d.Area, err = strconv.ParseFloat(parcel.Area, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid parcel area %q", parcel.Area)
+		}
+	}
+	for i, g := range parcel.CoordGeom.Elements {
+		var mete Mete
+		switch g.XMLName.Local {
+		case "Line":
+			mete, err = g.line(unit)
+		case "Curve":
+			mete, err = g.curve(unit)
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("element %d: %v", i+1, err)
+		}
+		if d.StartCoordinates == nil && g.Start != "" {
+			start, err := landXMLPoint(g.Start)
+			if err != nil {
+				return nil, fmt.Errorf("element %d: %v", i+1, err)
+			}
+			d.StartCoordinates = &start
+		}
+		d.Metes = append(d.Metes, mete)
+	}
+	if len(d.Metes) == 0 {
+		return nil, fmt.Errorf("parcel %q has no lines or curves", parcel.Name)
+	}
+	if d.StartCoordinates == nil {
+		d.PreTied = true
+	}
+	return d, nil
+}
+
+// line reads a Line element
+func (g landXMLGeom) line(unit string) (*LinearMete, error) {
+	if g.Start != "" && g.End != "" {
+		start, err := landXMLPoint(g.Start)
+		if err != nil {
+			return nil, err
+		}
+		end, err := landXMLPoint(g.End)
+		if err != nil {
+			return nil, err
+		}
+		b, distance := Inverse(start, end)
+		mete := NewLinearMete(b.ToAngle(), distance, unit)
+		return &mete, nil
+	}
+	dir, err := landXMLNumber("dir", g.Dir)
+	if err != nil {
+		return nil, err
+	}
+	length, err := landXMLNumber("length", g.Length)
+	if err != nil {
+		return nil, err
+	}
+	mete := NewLinearMete(dir*math.Pi/180.0, length, unit)
+	return &mete, nil
+}
+
+// curve reads a Curve element
+func (g landXMLGeom) curve(unit string) (*ArcMete, error) {
+	var rot Rotation
+	switch strings.ToLower(g.Rot) {
+	case "cw":
+		rot = Clockwise
+	case "ccw":
+		rot = CounterClockwise
+	default:
+		return nil, fmt.Errorf("invalid curve rotation %q", g.Rot)
+	}
+	if g.Start != "" && g.Center != "" && g.End != "" {
+		start, err := landXMLPoint(g.Start)
+		if err != nil {
+			return nil, err
+		}
+		center, err := landXMLPoint(g.Center)
+		if err != nil {
+			return nil, err
+		}
+		end, err := landXMLPoint(g.End)
+		if err != nil {
+			return nil, err
+		}
+		toStart, radius := Inverse(center, start)
+		toEnd, _ := Inverse(center, end)
+		delta := normalizeAngle(float64(rot) * (toEnd.ToAngle() - toStart.ToAngle()))
+		if delta == 0.0 {
+			return nil, fmt.Errorf("curve has no central angle")
+		}
+		return NewArcMete(delta, radius, toStart.ToAngle()+float64(rot)*math.Pi/2.0, unit, rot), nil
+	}
+	radius, err := landXMLNumber("radius", g.Radius)
+	if err != nil {
+		return nil, err
+	}
+	delta, err := landXMLNumber("delta", g.Delta)
+	if err != nil {
+		return nil, err
+	}
+	dirStart, err := landXMLNumber("dirStart", g.DirStart)
+	if err != nil {
+		return nil, err
+	}
+	return NewArcMete(delta*math.Pi/180.0, radius, dirStart*math.Pi/180.0, unit, rot), nil
+}
+
+// landXMLPoint reads a point given as "northing easting" into an (easting, northing) coordinate
+func landXMLPoint(text string) ([2]float64, error) {
+	fields := strings.Fields(text)
+	if len(fields) < 2 {
+		return [2]float64{}, fmt.Errorf("invalid point %q", text)
+	}
+	northing, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return [2]float64{}, fmt.Errorf("invalid point %q", text)
+	}
+	easting, err := strconv.ParseFloat(fields[1], 64)
+	if err != nil {
+		return [2]float64{}, fmt.Errorf("invalid point %q", text)
+	}
+	return [2]float64{easting, northing}, nil
+}
+
+// landXMLNumber reads a required numeric attribute
+func landXMLNumber(name, value string) (float64, error) {
+	if value == "" {
+		return 0.0, fmt.Errorf("missing %s", name)
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0.0, fmt.Errorf("invalid %s %q", name, value)
+	}
+	return f, nil
+}
+
+// landXMLOut is a LandXML document holding a single parcel
+type landXMLOut struct {
+	XMLName xml.Name `xml:"LandXML"`
+	Xmlns   string   `xml:"xmlns,attr"`
+	Version string   `xml:"version,attr"`
+	Units   struct {
+		Imperial *landXMLUnits `xml:"Imperial,omitempty"`
+		Metric   *landXMLUnits `xml:"Metric,omitempty"`
+	} `xml:"Units"`
+	Parcel struct {
+		Name      string `xml:"name,attr,omitempty"`
+		Area      string `xml:"area,attr,omitempty"`
+		CoordGeom struct {
+			Elements []interface{} `xml:",any"`
+		} `xml:"CoordGeom"`
+	} `xml:"Parcels>Parcel"`
+}
+
+// landXMLLine is a Line element of a parcel boundary
+type landXMLLine struct {
+	XMLName xml.Name `xml:"Line"`
+	Dir     string   `xml:"dir,attr"`
+	Length  string   `xml:"length,attr"`
+	Start   string   `xml:"Start"`
+	End     string   `xml:"End"`
+}
+
+// landXMLCurve is a Curve element of a parcel boundary. chordDir is not part of the LandXML schema and is ignored by
+// software which does not recognize it.
+type landXMLCurve struct {
+	XMLName  xml.Name `xml:"Curve"`
+	Rot      string   `xml:"rot,attr"`
+	CrvType  string   `xml:"crvType,attr"`
+	Radius   string   `xml:"radius,attr"`
+	Delta    string   `xml:"delta,attr"`
+	Length   string   `xml:"length,attr"`
+	DirStart string   `xml:"dirStart,attr"`
+	DirEnd   string   `xml:"dirEnd,attr"`
+	Chord    string   `xml:"chord,attr"`
+	ChordDir string   `xml:"chordDir,attr"`
+	Start    string   `xml:"Start"`
+	Center   string   `xml:"Center"`
+	End      string   `xml:"End"`
+}
+
+// LandXML writes the parcel boundary as a LandXML Parcel with a CoordGeom of Line and Curve elements, beginning at
+// origin. Directions are written as azimuths in decimal degrees, and curves carry their rotation, radius and the
+// azimuth of their chord. Only boundaries in feet, US survey feet or meters can be written.
+func (d *Description) LandXML(origin Point) ([]byte, error) {
+	metes, unit, err := toCommonUnit(d.boundary())
+	if err != nil {
+		return nil, err
+	}
+	var doc landXMLOut
+	doc.Xmlns = "http://www.landxml.org/schema/LandXML-1.2"
+	doc.Version = "1.2"
+	units := &landXMLUnits{LinearUnit: landXMLName(landXMLLinearUnits, unit)}
+	if units.LinearUnit == "" {
+		if unit != "" {
+			return nil, fmt.Errorf("unit %s can not be written to LandXML", unit)
+		}
+		units.LinearUnit = "foot"
+	}
+	if d.Area != 0.0 {
+		if units.AreaUnit = landXMLName(landXMLAreaUnits, strings.ToUpper(strings.TrimSpace(d.Unit))); units.AreaUnit != "" {
+			doc.Parcel.Area = strconv.FormatFloat(d.Area, 'f', -1, 64)
+		}
+	}
+	if units.LinearUnit == "meter" {
+		doc.Units.Metric = units
+	} else {
+		doc.Units.Imperial = units
+	}
+	doc.Parcel.Name = d.Kind
+	vertices, err := Coordinates([2]float64{origin.X, origin.Y}, metes)
+	if err != nil {
+		return nil, err
+	}
+	for i, m := range metes {
+		start, end := landXMLText(vertices[i]), landXMLText(vertices[i+1])
+		switch mete := m.(type) {
+		case *LinearMete:
+			doc.Parcel.CoordGeom.Elements = append(doc.Parcel.CoordGeom.Elements, landXMLLine{
+				Dir:    landXMLAzimuth(mete.bearing),
+				Length: dxfFloat(mete.distance),
+				Start:  start,
+				End:    end,
+			})
+		case *ArcMete:
+			rot := "cw"
+			if mete.dir == CounterClockwise {
+				rot = "ccw"
+			}
+			doc.Parcel.CoordGeom.Elements = append(doc.Parcel.CoordGeom.Elements, landXMLCurve{
+				Rot:      rot,
+				CrvType:  "arc",
+				Radius:   dxfFloat(mete.radius),
+				Delta:    dxfFloat(mete.centralAngle * 180.0 / math.Pi),
+				Length:   dxfFloat(mete.ArcLength()),
+				DirStart: landXMLAzimuth(mete.tangent),
+				DirEnd:   landXMLAzimuth(endTangent(mete)),
+				Chord:    dxfFloat(mete.ChordLength()),
+				ChordDir: landXMLAzimuth(mete.ChordAngle()),
+				Start:    start,
+				Center:   landXMLText(mete.Center(vertices[i])),
+				End:      end,
+			})
+		default:
+			return nil, fmt.Errorf("mete %d: unsupported mete type %T", i+1, m)
+		}
+	}
+	data, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), data...), nil
+}
+
+// landXMLName is the LandXML name of a unit as it is written in a description, or empty if it has none
+func landXMLName(names map[string]string, unit string) string {
+	for name, u := range names {
+		if u == unit {
+			return name
+		}
+	}
+	return ""
+}
+
+// landXMLText writes an (easting, northing) coordinate as a LandXML point of "northing easting"
+func landXMLText(p [2]float64) string {
+	return dxfFloat(p[1]) + " " + dxfFloat(p[0])
+}
+
+// landXMLAzimuth writes an angle in radians as an azimuth in decimal degrees
+func landXMLAzimuth(angle float64) string {
+	return dxfFloat(normalizeAngle(angle) * 180.0 / math.Pi)
+}
@@ -5,8 +5,9 @@ package legal
 // break this into multiple files
 
 import (
-	"bytes"
+	"encoding/json"
 	"fmt"
+	"io"
 	"math"
 	"regexp"
 	"strconv"
@@ -17,7 +18,7 @@ import (
 //Direction is an enumeration of cardinal directions
 type Direction int
 
-//Cardinal directions proceeding north counterclockwise
+//Cardinal directions proceeding clockwise from north
 const (
 	North Direction = iota
 	NorthEast
@@ -27,6 +28,15 @@ const (
 	SouthWest
 	West
 	NorthWest
+	// the points of a sixteen point compass lying between the directions above, clockwise from north
+	NorthNorthEast
+	EastNorthEast
+	EastSouthEast
+	SouthSouthEast
+	SouthSouthWest
+	WestSouthWest
+	WestNorthWest
+	NorthNorthWest
 )
 
 func dirMap() map[string]Direction {
@@ -47,6 +57,23 @@ func dirMap() map[string]Direction {
 		"SW":        SouthWest,
 		"W":         West,
 		"NW":        NorthWest,
+
+		"NORTH-NORTHEAST": NorthNorthEast,
+		"EAST-NORTHEAST":  EastNorthEast,
+		"EAST-SOUTHEAST":  EastSouthEast,
+		"SOUTH-SOUTHEAST": SouthSouthEast,
+		"SOUTH-SOUTHWEST": SouthSouthWest,
+		"WEST-SOUTHWEST":  WestSouthWest,
+		"WEST-NORTHWEST":  WestNorthWest,
+		"NORTH-NORTHWEST": NorthNorthWest,
+		"NNE":             NorthNorthEast,
+		"ENE":             EastNorthEast,
+		"ESE":             EastSouthEast,
+		"SSE":             SouthSouthEast,
+		"SSW":             SouthSouthWest,
+		"WSW":             WestSouthWest,
+		"WNW":             WestNorthWest,
+		"NNW":             NorthNorthWest,
 	}
 }
 
@@ -62,43 +89,64 @@ func DirectionFromString(s string) (Direction, bool) {
 	return d, true
 }
 
-// DirectionFromAngle presumes that the angle is provided in radians
+// CornerFromString reads the corner of a parcel, which is one of the eight cardinal and intercardinal directions.
+// The points between them on a sixteen point compass are not corners.
+func CornerFromString(s string) (Direction, bool) {
+	d, ok := DirectionFromString(s)
+	if !ok || d > NorthWest {
+		return 0, false
+	}
+	return d, true
+}
+
+// DirectionFromAngle presumes that the angle is provided in radians, clockwise from north. The circle is divided into
+// eight sectors centered on each direction, and an angle halfway between two directions, to within rounding error,
+// rounds clockwise.
 func DirectionFromAngle(angle float64) Direction {
-	angle = math.Mod(angle, (2 * math.Pi))
-	switch {
-	case angle == 0.0:
-		return North
-	case angle < math.Pi/4:
-		return NorthEast
-	case angle == math.Pi/4:
-		return East
-	case angle < math.Pi/2:
-		return SouthEast
-	case angle == math.Pi/2:
-		return South
-	case angle < math.Pi*3/4:
-		return SouthWest
-	case angle == math.Pi*3/4:
-		return West
-	default:
-		return NorthWest // angle cannot exceed 2pi.
+	const epsilon = 1e-9
+	angle = math.Mod(angle, 2*math.Pi)
+	if angle < 0.0 {
+		angle += 2 * math.Pi
+	}
+	sector := int(math.Floor(angle/(math.Pi/4.0)+0.5+epsilon)) % 8
+	return Direction(sector)
+}
+
+// DirectionFromAngle16 is DirectionFromAngle on a sixteen point compass, so that the points between the cardinal and
+// intercardinal directions, ie NORTH-NORTHEAST, are distinguished.
+func DirectionFromAngle16(angle float64) Direction {
+	const epsilon = 1e-9
+	angle = math.Mod(angle, 2*math.Pi)
+	if angle < 0.0 {
+		angle += 2 * math.Pi
+	}
+	sector := int(math.Floor(angle/(math.Pi/8.0)+0.5+epsilon)) % 16
+	if sector%2 == 0 {
+		return Direction(sector / 2)
 	}
+	return NorthNorthEast + Direction(sector/2)
+}
+
+// angle is the angle in radians of a direction, clockwise from north
+func (d Direction) angle() float64 {
+	if d >= NorthNorthEast {
+		return float64(2*(d-NorthNorthEast)+1) * math.Pi / 8.0
+	}
+	return float64(d) * math.Pi / 4.0
 }
 
 //Describe returns the string representation of a direction
 func (d Direction) Describe() string {
-	dirNames := [8]string{"NORTH", "NORTHEAST", "EAST", "SOUTHEAST", "SOUTH", "SOUTHWEST", "WEST", "NORTHWEST"}
+	dirNames := [16]string{"NORTH", "NORTHEAST", "EAST", "SOUTHEAST", "SOUTH", "SOUTHWEST", "WEST", "NORTHWEST",
+		"NORTH-NORTHEAST", "EAST-NORTHEAST", "EAST-SOUTHEAST", "SOUTH-SOUTHEAST", "SOUTH-SOUTHWEST", "WEST-SOUTHWEST",
+		"WEST-NORTHWEST", "NORTH-NORTHWEST"}
 	return dirNames[d]
 }
 
-// Bearing is a direction of a survey. This follows the convention of representing bearings as degrees-minutes-seconds
-// TODO: Make Bearing a plain float64 in radians and marshall/unmarshall into DMS at boundaries.
+// Bearing is a direction of a survey. It is held as an angle in radians clockwise from north and is only split into
+// the quadrant and degrees-minutes-seconds convention of bearings when it is described.
 type Bearing struct {
-	primary   Direction
-	deg       int
-	min       int
-	sec       float64
-	secondary Direction
+	angle float64
 }
 
 // NewBearing creates a bearing from a known quadrant and angle. example: NewBearing(North,East,15,30,45)
@@ -106,105 +154,398 @@ func NewBearing(p, snd Direction, d, m int, s float64) (Bearing, error) {
 	if int(p)%2 != 0 || int(snd)%2 != 0 {
 		return Bearing{}, fmt.Errorf("%s - %s are not valid directions for a bearing", p.Describe(), snd.Describe())
 	}
-	if d < 0 || d > 90 || m < 0 || m > 60 || s < 0.0 || s > 60.0 {
+	if d < 0 || d > 90 || m < 0 || m >= 60 || s < 0.0 || s >= 60.0 || (d == 90 && (m > 0 || s > 0.0)) {
 		return Bearing{}, fmt.Errorf("%d %d %f is not a valid direction", d, m, s)
 	}
-	return Bearing{primary: p, deg: d, min: m, sec: s, secondary: snd}, nil
+	return Bearing{angle: quadrantAngle(p, snd, d, m, s)}, nil
 }
 
-var regBearing = regexp.MustCompile(`(?P<primary>[N|S])\D*(?P<deg>\d+)[D|°](?P<min>\d+)[M|'](?P<sec>\d+\.?\d*)[S|"](?P<secondary>[E|W])`)
+// NormalizeDMS carries whole minutes out of the seconds and whole degrees out of the minutes of an angle, so that
+// 29°59'60" becomes 30°0'0". Negative minutes or seconds borrow from the place above them.
+func NormalizeDMS(d, m int, s float64) (int, int, float64) {
+	carry := math.Floor(s / 60.0)
+	s -= carry * 60.0
+	m += int(carry)
+	d += int(math.Floor(float64(m) / 60.0))
+	m -= int(math.Floor(float64(m)/60.0)) * 60
+	return d, m, s
+}
 
-// Describe is a string representation of a bearing for a legal description
-func (b *Bearing) Describe() string {
-	return fmt.Sprintf("%s %d°%d'%.2f\" %s", b.primary.Describe(), b.deg, b.min, b.sec, b.secondary.Describe())
+// quadrantAngle is the angle in radians clockwise from north, in the range [0, 2pi), of a quadrant bearing
+func quadrantAngle(p, snd Direction, d, m int, s float64) float64 {
+	var start, rotation float64
+	if p == North {
+		start = 0.0
+	} else {
+		start = 180.0
+	}
+	if (p == North && snd == East) || (p == South && snd == West) {
+		rotation = 1.0
+	} else {
+		rotation = -1.0
+	}
+	return normalizeAngle((start + rotation*(float64(d)+float64(m)/60.0+s/3600.0)) / 180.0 * math.Pi)
 }
 
-// FromAngle construct a bearing from an angle in radians
-func (b *Bearing) FromAngle(theta float64) {
+// normalizeAngle brings an angle in radians into the range [0, 2pi)
+func normalizeAngle(theta float64) float64 {
+	theta = math.Mod(theta, math.Pi*2.0)
 	if theta < 0.0 {
-		theta = math.Abs(theta) + math.Pi
+		theta += math.Pi * 2.0
 	}
-	theta = math.Mod(theta, math.Pi*2.0)
-	var primary, secondary Direction
+	return theta
+}
+
+// parts splits the bearing into its quadrant and its angle from the meridian, with the seconds rounded to the given
+// number of decimal places. Rounding carries into the minutes and degrees so that seconds never read 60.
+func (b *Bearing) parts(places int) (primary Direction, deg, min int, sec float64, secondary Direction) {
+	theta := normalizeAngle(b.angle)
 	switch {
 	case theta < math.Pi/2.0:
-		primary = North
-		secondary = East
+		primary, secondary = North, East
 	case theta < math.Pi:
-		primary = South
-		secondary = East
+		primary, secondary = South, East
 		theta = math.Pi - theta
 	case theta < math.Pi*3.0/2.0:
-		primary = South
-		secondary = West
+		primary, secondary = South, West
 		theta = theta - math.Pi
 	default:
-		primary = North
-		secondary = West
+		primary, secondary = North, West
 		theta = math.Pi*2.0 - theta
 	}
-	total := theta * 180.0 / math.Pi
-	degrees := math.Floor(total)
-	totalMinutes := (total - degrees) * 60.0
-	minutes := math.Floor(totalMinutes)
-	seconds := (totalMinutes - minutes) * 60.0
-	b.primary = primary
-	b.secondary = secondary
-	b.deg = int(degrees)
-	b.min = int(minutes)
-	b.sec = seconds
+	scale := math.Pow(10.0, float64(places))
+	total := math.Round(theta*180.0/math.Pi*3600.0*scale) / scale
+	deg = int(total / 3600.0)
+	min = int((total - float64(deg)*3600.0) / 60.0)
+	sec = math.Round((total-float64(deg)*3600.0-float64(min)*60.0)*scale) / scale
+	return primary, deg, min, sec, secondary
+}
+
+// exactPlaces is the precision in decimal places of seconds to which a bearing is split when it is not being printed
+const exactPlaces = 6
+
+var regBearing = regexp.MustCompile(`(?P<primary>[N|S])\D*(?P<deg>\d+)(?:DEGREES|DEG|D|°)(?P<min>\d+)(?:MINUTES|MIN|M|'|′)(?P<sec>\d+\.?\d*)(?:SECONDS|SEC|S|"|″)(?P<secondary>[E|W])`)
+
+// regBearingDirectionsFirst matches legacy bearings which state both directions before the angle, ie N W 10°15'30"
+var regBearingDirectionsFirst = regexp.MustCompile(`(?P<primary>[N|S])\D*?(?P<secondary>[E|W])\D*(?P<deg>\d+)(?:DEGREES|DEG|D|°)(?P<min>\d+)(?:MINUTES|MIN|M|'|′)(?P<sec>\d+\.?\d*)(?:SECONDS|SEC|S|"|″)`)
+
+// regBearingNoSeconds matches bearings stated only to the minute, which may be decimal, ie N 10°15' W or N 5°30.5' W
+var regBearingNoSeconds = regexp.MustCompile(`(?P<primary>[N|S])\D*(?P<deg>\d+)(?:DEGREES|DEG|D|°)(?P<min>\d+\.?\d*)(?:MINUTES|MIN|M|'|′)(?P<secondary>[E|W])`)
+
+// regBearingDecimal matches bearings whose angle is given in decimal degrees without any delimiters, ie N12.5816E
+var regBearingDecimal = regexp.MustCompile(`^([NS])[A-Z]*?(\d+(?:\.\d+)?)([EW])[A-Z]*$`)
+
+// regBearingDue matches bearings along an axis, ie DUE NORTH
+var regBearingDue = regexp.MustCompile(`^DUE(NORTH|SOUTH|EAST|WEST|N|S|E|W)$`)
+
+// bearingFields extracts the primary direction, degrees, minutes, seconds and secondary direction from a preprocessed
+// bearing string, in that order, whichever order the string states them in. Seconds are empty if they are not stated.
+// Decimal degrees are split into degrees, minutes and seconds.
+func bearingFields(str string) []string {
+	if subs := regBearingDecimal.FindStringSubmatch(str); subs != nil {
+		decimal, err := strconv.ParseFloat(subs[2], 64)
+		if err != nil {
+			return nil
+		}
+		deg := math.Floor(decimal)
+		min := math.Floor((decimal - deg) * 60.0)
+		sec := (decimal-deg)*3600.0 - min*60.0
+		return []string{subs[1], strconv.Itoa(int(deg)), strconv.Itoa(int(min)), strconv.FormatFloat(sec, 'f', -1, 64), subs[3]}
+	}
+	for _, re := range []*regexp.Regexp{regBearing, regBearingDirectionsFirst, regBearingNoSeconds} {
+		subs := re.FindStringSubmatch(str)
+		if subs == nil {
+			continue
+		}
+		fields := make([]string, 5)
+		for i, name := range []string{"primary", "deg", "min", "sec", "secondary"} {
+			if idx := re.SubexpIndex(name); idx != -1 {
+				fields[i] = subs[idx]
+			}
+		}
+		return fields
+	}
+	return nil
+}
+
+// Describe is a string representation of a bearing for a legal description
+func (b *Bearing) Describe() string {
+	return b.DescribeSymbols(ASCIISymbols)
+}
+
+// DescribeSymbols is a string representation of a bearing for a legal description, marking minutes and seconds with
+// the given symbols
+func (b *Bearing) DescribeSymbols(symbols AngleSymbols) string {
+	return b.describe(symbols, 2)
+}
+
+// describe is a string representation of a bearing with its seconds given to the number of decimal places
+func (b *Bearing) describe(symbols AngleSymbols, places int) string {
+	if due, ok := b.due(places); ok {
+		return "DUE " + due.Describe()
+	}
+	degree, minute, second := symbols.marks()
+	primary, deg, min, sec, secondary := b.parts(places)
+	return fmt.Sprintf("%s %d%s%d%s%.*f%s %s", primary.Describe(), deg, degree, min, minute, places, sec, second, secondary.Describe())
+}
+
+// DescribeWords is a fully spelled representation of a bearing, ie SOUTH 88 DEGREES 21 MINUTES 22 SECONDS EAST, as
+// required verbatim by many title companies.
+func (b *Bearing) DescribeWords() string {
+	if due, ok := b.due(2); ok {
+		return "DUE " + due.Describe()
+	}
+	primary, deg, min, sec, secondary := b.parts(2)
+	return fmt.Sprintf("%s %d DEGREES %d MINUTES %s SECONDS %s", primary.Describe(), deg, min, strconv.FormatFloat(sec, 'f', -1, 64), secondary.Describe())
+}
+
+// due is the cardinal direction along which the bearing runs, if it lies on an axis to the given decimal places of a
+// second
+func (b *Bearing) due(places int) (Direction, bool) {
+	primary, deg, min, sec, secondary := b.parts(places)
+	if min != 0 || sec != 0.0 {
+		return North, false
+	}
+	switch deg {
+	case 0:
+		return primary, true
+	case 90:
+		return secondary, true
+	}
+	return North, false
+}
+
+// RoundToMinute is the bearing rounded to the nearest minute, for deeds which only carry minutes. Seconds are rounded
+// into the minutes, rolling over into the degrees as needed, and zeroed.
+func (b *Bearing) RoundToMinute() Bearing {
+	primary, deg, min, sec, secondary := b.parts(exactPlaces)
+	if sec >= 30.0 {
+		min++
+	}
+	if min >= 60 {
+		min -= 60
+		deg++
+	}
+	return Bearing{angle: quadrantAngle(primary, secondary, deg, min, 0.0)}
+}
+
+// Compact is a short representation of a bearing suitable for tabular data, ie N10-15-30W
+func (b *Bearing) Compact() string {
+	primary, deg, min, sec, secondary := b.parts(2)
+	return fmt.Sprintf("%s%d-%d-%s%s", primary.Describe()[:1], deg, min, strconv.FormatFloat(sec, 'f', -1, 64), secondary.Describe()[:1])
+}
+
+// dms formats an angle in radians as degrees, minutes and seconds
+func dms(angle float64) string {
+	return dmsWith(angle, ASCIISymbols, 2)
+}
+
+// dmsWith formats an angle in radians as degrees, minutes and seconds marked with the given symbols, with the seconds
+// rounded to the given number of decimal places
+func dmsWith(angle float64, symbols AngleSymbols, places int) string {
+	sign := ""
+	if angle < 0.0 {
+		sign = "-"
+		angle = -angle
+	}
+	degrees, minutes, seconds := dmsParts(angle, places)
+	degree, minute, second := symbols.marks()
+	return fmt.Sprintf("%s%d%s%d%s%.*f%s", sign, degrees, degree, minutes, minute, places, seconds, second)
+}
+
+// dmsParts splits a positive angle in radians into degrees, minutes and seconds. The seconds are rounded to the given
+// number of decimal places before the angle is split, so that rounding carries into the minutes and degrees.
+func dmsParts(angle float64, places int) (degrees, minutes int, seconds float64) {
+	scale := math.Pow(10.0, float64(places))
+	total := math.Round(angle*180.0/math.Pi*3600.0*scale) / scale
+	d := math.Floor(total / 3600.0)
+	m := math.Floor((total - d*3600.0) / 60.0)
+	seconds = math.Round((total-d*3600.0-m*60.0)*scale) / scale
+	return int(d), int(m), seconds
+}
+
+var regDMS = regexp.MustCompile(`(?P<deg>\d+)(?:DEGREES|DEG|D|°)(?P<min>\d+)(?:MINUTES|MIN|M|'|′)(?P<sec>\d+\.?\d*)(?:SECONDS|SEC|S|"|″)`)
+
+// parseDMS reads an angle given in degrees, minutes and seconds, returning it in radians
+func parseDMS(strsrc string) (float64, error) {
+	str := strings.ToUpper(strings.Join(strings.Fields(strsrc), ""))
+	subs := regDMS.FindStringSubmatch(str)
+	if subs == nil {
+		return 0.0, fmt.Errorf("Invalid angle %v", strsrc)
+	}
+	deg, err := strconv.Atoi(subs[1])
+	if err != nil {
+		return 0.0, fmt.Errorf("Invalid degrees %v", subs[1])
+	}
+	min, err := strconv.Atoi(subs[2])
+	if err != nil {
+		return 0.0, fmt.Errorf("Invalid minutes %v", subs[2])
+	}
+	sec, err := strconv.ParseFloat(subs[3], 64)
+	if err != nil {
+		return 0.0, fmt.Errorf("Invalid seconds %v", subs[3])
+	}
+	return (float64(deg) + float64(min)/60.0 + sec/3600.0) * math.Pi / 180.0, nil
+}
+
+// FromAngle construct a bearing from an angle in radians
+func (b *Bearing) FromAngle(theta float64) {
+	b.angle = normalizeAngle(theta)
+}
+
+// FromGon constructs a bearing from an azimuth in gon (gradians), of which there are 400 to the circle, clockwise from
+// north
+func (b *Bearing) FromGon(gon float64) {
+	b.FromAngle(gon * math.Pi / 200.0)
+}
+
+// Gon is the azimuth of the bearing in gon (gradians), from 0 up to 400, clockwise from north
+func (b *Bearing) Gon() float64 {
+	return b.angle * 200.0 / math.Pi
+}
+
+// fromDue sets the bearing to run along the axis in the given cardinal direction
+func (b *Bearing) fromDue(dir string) error {
+	d, ok := DirectionFromString(dir)
+	if !ok || d > NorthWest || int(d)%2 != 0 {
+		return fmt.Errorf("Invalid due direction %v", dir)
+	}
+	b.angle = d.angle()
+	return nil
 }
 
 // FromString attempts to parse a string representation of a Bearing.
 func (b *Bearing) FromString(strsrc string) error {
+	return b.parse(strsrc, false)
+}
+
+// parse reads a string representation of a Bearing. Bearings without seconds are rejected when strict, otherwise
+// their seconds are taken to be zero.
+func (b *Bearing) parse(strsrc string, strict bool) error {
+	err := b.read(strsrc, strict)
+	if err != nil {
+		return &ParseError{Field: "bearing", Token: strings.TrimSpace(strsrc), Err: err}
+	}
+	return nil
+}
+
+// read sets the bearing from its string representation
+func (b *Bearing) read(strsrc string, strict bool) error {
 	str := strings.ToUpper(strings.Join(strings.Fields(strsrc), "")) // preprocess for consistency. Eliminate whitespace
-	subs := regBearing.FindStringSubmatch(str)
-	if len(subs) != 6 {
-		return fmt.Errorf("Invalid bearing string: (%v) insufficient number of matches", subs)
+	if due := regBearingDue.FindStringSubmatch(str); due != nil {
+		return b.fromDue(due[1])
+	}
+	subs := bearingFields(str)
+	if len(subs) != 5 {
+		return fmt.Errorf("Invalid bearing string: %q is not a quadrant bearing", strsrc)
 	}
-	subs = subs[1:]
 	primary, ok := DirectionFromString(subs[0])
 	if !ok {
 		return fmt.Errorf("Invalid primary direction: %v", subs[0])
 	}
-	b.primary = primary
 	deg, err := strconv.Atoi(subs[1])
 	if err != nil {
 		return fmt.Errorf("Invalid degrees %v", subs[1])
 	}
-	b.deg = deg
-	min, err := strconv.Atoi(subs[2])
+	minutes, err := strconv.ParseFloat(subs[2], 64)
 	if err != nil {
 		return fmt.Errorf("Invalid minutes %v", subs[2])
 	}
-	b.min = min
+	min := int(minutes)
+	decimalMinutes := strings.Contains(subs[2], ".")
+	if subs[3] == "" {
+		if strict && !decimalMinutes {
+			return fmt.Errorf("Missing seconds in bearing %v", strsrc)
+		}
+		subs[3] = strconv.FormatFloat((minutes-float64(min))*60.0, 'f', -1, 64)
+	}
 	sec, err := strconv.ParseFloat(subs[3], 0)
 	if err != nil {
 		return fmt.Errorf("Invalid seconds %v", subs[3])
 	}
-	b.sec = sec
+	if deg > 90 || min >= 60 || sec >= 60.0 || (deg == 90 && (min > 0 || sec > 0.0)) {
+		return fmt.Errorf("Invalid angle %d°%v'%v\" in bearing %v", deg, subs[2], subs[3], strsrc)
+	}
 	secondary, ok := DirectionFromString(subs[4])
 	if !ok {
 		return fmt.Errorf("Invalid secondary direction %v", subs[4])
 	}
-	b.secondary = secondary
+	b.angle = quadrantAngle(primary, secondary, deg, min, sec)
 	return nil
 }
 
 // ToAngle returns the angle in radians given by a bearing
 func (b *Bearing) ToAngle() float64 {
-	var start, rotation float64
-	if b.primary == North {
-		start = 0.0
-	} else {
-		start = 180.0
+	return b.angle
+}
+
+// DeflectionTo is the angle in radians turned from the prolongation of this bearing onto the next, between 0 and pi,
+// and the direction of the turn. Turning right is clockwise.
+func (b Bearing) DeflectionTo(next Bearing) (deflection float64, rotation Rotation) {
+	turn := math.Remainder(next.angle-b.angle, 2.0*math.Pi)
+	if turn < 0.0 {
+		return -turn, CounterClockwise
 	}
-	if (b.primary == North && b.secondary == East) || (b.primary == South && b.secondary == West) {
-		rotation = 1.0
-	} else {
-		rotation = -1.0
+	return turn, Clockwise
+}
+
+// bearingJSON is the serialized form of a bearing
+type bearingJSON struct {
+	Primary   string  `json:"primary"`
+	Deg       int     `json:"deg"`
+	Min       int     `json:"min"`
+	Sec       float64 `json:"sec"`
+	Secondary string  `json:"secondary"`
+}
+
+// MarshalJSON encodes the bearing as an object giving its directions and angle, ie
+// {"primary":"NORTH","deg":10,"min":15,"sec":30,"secondary":"WEST"}
+func (b Bearing) MarshalJSON() ([]byte, error) {
+	primary, deg, min, sec, secondary := b.parts(exactPlaces)
+	return json.Marshal(bearingJSON{
+		Primary:   primary.Describe(),
+		Deg:       deg,
+		Min:       min,
+		Sec:       sec,
+		Secondary: secondary.Describe(),
+	})
+}
+
+// UnmarshalJSON decodes a bearing encoded by MarshalJSON, enforcing the same constraints as NewBearing
+func (b *Bearing) UnmarshalJSON(data []byte) error {
+	var src bearingJSON
+	err := json.Unmarshal(data, &src)
+	if err != nil {
+		return err
 	}
-	return (start + rotation*(float64(b.deg)+float64(b.min)/60.0+b.sec/3600.0)) / 180.0 * math.Pi
+	primary, ok := DirectionFromString(src.Primary)
+	if !ok || (primary != North && primary != South) {
+		return fmt.Errorf("%q is not a valid primary direction for a bearing", src.Primary)
+	}
+	secondary, ok := DirectionFromString(src.Secondary)
+	if !ok || (secondary != East && secondary != West) {
+		return fmt.Errorf("%q is not a valid secondary direction for a bearing", src.Secondary)
+	}
+	parsed, err := NewBearing(primary, secondary, src.Deg, src.Min, src.Sec)
+	if err != nil {
+		return err
+	}
+	*b = parsed
+	return nil
+}
+
+// FromAzimuth sets the bearing from an azimuth in decimal degrees clockwise from north
+func (b *Bearing) FromAzimuth(azimuthDeg float64) {
+	b.FromAngle(azimuthDeg * math.Pi / 180.0)
+}
+
+// Azimuth is the bearing as an azimuth in decimal degrees clockwise from north, in the range [0, 360)
+func (b *Bearing) Azimuth() float64 {
+	azimuth := math.Mod(b.ToAngle()*180.0/math.Pi, 360.0)
+	if azimuth < 0.0 {
+		azimuth += 360.0
+	}
+	return azimuth
 }
 
 // Mete is a boundary used in a legal description. Metes can represent very different boundary types, but they must all produce a self-description
@@ -214,11 +555,41 @@ type Mete interface {
 	Tangent() float64
 }
 
+// StyledMete is a Mete which can be described in the style of a FormatOptions. A Mete which is not is described as
+// it describes itself, whatever the style.
+type StyledMete interface {
+	Mete
+	DescribeWith(FormatOptions) string
+	PreambleWith(float64, FormatOptions) string
+}
+
+// describeWith describes the mete in the given style if it has one
+func describeWith(m Mete, opts FormatOptions) string {
+	if sm, ok := m.(StyledMete); ok {
+		return sm.DescribeWith(opts)
+	}
+	return m.Describe()
+}
+
+// preambleWith describes the mete with respect to the previous in the given style if it has one
+func preambleWith(m Mete, prevTan float64, opts FormatOptions) string {
+	if sm, ok := m.(StyledMete); ok {
+		return sm.PreambleWith(prevTan, opts)
+	}
+	return m.Preamble(prevTan)
+}
+
 // LinearMete is a boundary defined by a straight line.
 type LinearMete struct {
 	bearing  float64
 	distance float64
 	unit     string
+	// CommonLine names the parcels sharing this boundary (ie "LOTS 5 AND 6"), if any.
+	CommonLine string
+	// Aliquot is the line of a section subdivision which this boundary follows, if any.
+	Aliquot *AliquotLine
+	// EndMonument is the monument found at the end of the course, if any, ie A FOUND 1/2" IRON PIN
+	EndMonument string
 }
 
 func NewLinearMete(angle, distance float64, unit string) LinearMete {
@@ -236,15 +607,46 @@ func (m *LinearMete) Tangent() float64 {
 
 // Describe returns a snippet of a legal description for a specific bearing
 func (m *LinearMete) Describe() string {
+	return m.DescribeWith(FormatOptions{})
+}
+
+// DescribeWith returns a snippet of a legal description for a specific bearing in the given style
+func (m *LinearMete) DescribeWith(opts FormatOptions) string {
 	var b Bearing
 	b.FromAngle(m.bearing)
-	brng := b.Describe()
-	return fmt.Sprintf("%s A DISTANCE OF %.2f %s", brng, m.distance, strings.ToUpper(m.unit))
+	brng := opts.bearing(&b)
+	return commonLine(m.CommonLine) + m.Aliquot.Describe() + fmt.Sprintf("%s A DISTANCE OF %.*f %s", brng, opts.distancePlaces(), m.distance, strings.ToUpper(m.unit))
+}
+
+// describeTurned describes the mete by the interior angle it turns from a course ending in the direction prevTan. The
+// interior lies on the right of a parcel traversed clockwise and on the left of one traversed counterclockwise, so
+// the angle exceeds 180° at a reflex vertex, where the traverse turns away from the interior.
+func (m *LinearMete) describeTurned(prevTan float64, clockwise bool, opts FormatOptions) string {
+	var prev, b Bearing
+	prev.FromAngle(prevTan)
+	b.FromAngle(m.bearing)
+	deflection, rot := prev.DeflectionTo(b)
+	if rot == CounterClockwise {
+		deflection = -deflection
+	}
+	side := "RIGHT"
+	interior := math.Pi - deflection
+	if !clockwise {
+		side = "LEFT"
+		interior = math.Pi + deflection
+	}
+	angle := dmsWith(interior, opts.Symbols, opts.secondsPlaces())
+	return commonLine(m.CommonLine) + m.Aliquot.Describe() + fmt.Sprintf("TURNING AN INTERIOR ANGLE OF %s TO THE %s, A DISTANCE OF %.*f %s", angle, side, opts.distancePlaces(), m.distance, strings.ToUpper(m.unit))
 }
 
 // Preamble takes the tangent angle of a previous mete and describes the mete with respect to the previous (ie tangential or not)
 func (m *LinearMete) Preamble(prevTan float64) string {
-	if prevTan == m.bearing {
+	return m.PreambleWith(prevTan, FormatOptions{})
+}
+
+// PreambleWith describes the mete with respect to the previous in the given style
+func (m *LinearMete) PreambleWith(prevTan float64, opts FormatOptions) string {
+	if tangent(prevTan, m.bearing) {
 		return "A POINT OF TANGENCY"
 	}
 	return "A POINT OF NON-TANGENCY"
@@ -253,14 +655,23 @@ func (m *LinearMete) Preamble(prevTan float64) string {
 // FromString updates a Mete from a string as output from Autocad (ie THENCE (1) North..., 1.00 feet[;| to a point...])
 // this implementation is VERY specific to AutoCAD and needs to be modified to be useful otherwise
 func (m *LinearMete) FromString(line string) error {
+	return m.parse(line, false)
+}
+
+// parse reads a mete from a line of an AutoCAD report. Distances without units are rejected when strict, otherwise
+// they are taken to be in feet.
+func (m *LinearMete) parse(line string, strict bool) error {
 	bearingStart := strings.Index(line, ")")
+	if bearingStart == -1 {
+		bearingStart = strings.Index(line, ":") // calls labelled as "Course 1:"
+	}
 	bearingEnd := strings.Index(line, ",")
 	to := strings.Index(line, "to")
 	if bearingEnd == -1 || bearingStart == -1 {
-		return fmt.Errorf("Invalid mete description: %s", line)
+		return &ParseError{Field: "call", Token: line, Err: fmt.Errorf("no bearing and distance")}
 	}
 	var bearing Bearing
-	err := bearing.FromString(line[bearingStart:bearingEnd])
+	err := bearing.parse(line[bearingStart+1:bearingEnd], strict)
 	if err != nil {
 		return err
 	}
@@ -270,22 +681,61 @@ func (m *LinearMete) FromString(line string) error {
 	} else {
 		distSrc = line[bearingEnd:to]
 	}
-	distreg := regexp.MustCompile(`(\d+\.?\d*)\s?([a-zA-Z]+)`)
+	distreg := regexp.MustCompile(`(\d+\.?\d*)\s?([a-zA-Z]*)`)
 	results := distreg.FindStringSubmatch(distSrc)
 	if len(results) < 3 {
-		return fmt.Errorf("Invalid distance and units")
+		return &ParseError{Field: "distance", Token: strings.TrimSpace(distSrc), Err: fmt.Errorf("no distance and units")}
 	}
 	dist, err := strconv.ParseFloat(results[1], 64)
 	if err != nil {
-		return err
+		return &ParseError{Field: "distance", Token: results[1], Err: err}
 	}
 	unit := results[2]
+	if unit == "" {
+		if strict {
+			return &ParseError{Field: "unit", Token: strings.TrimSpace(distSrc), Err: fmt.Errorf("missing distance units")}
+		}
+		unit = "feet"
+	}
 	m.bearing = bearing.ToAngle()
 	m.distance = dist
 	m.unit = unit
+	m.EndMonument = ""
+	if to != -1 {
+		end := strings.ToUpper(strings.TrimRight(strings.TrimSpace(line[to+2:]), ";."))
+		if end != "" && !regPointReached.MatchString(end) {
+			m.EndMonument = end
+		}
+	}
 	return nil
 }
 
+// regPointReached matches the points at the end of a call which are not monuments, ie A POINT OF TANGENCY or THE
+// BEGINNING OF A CURVE
+var regPointReached = regexp.MustCompile(`^(A POINT|THE POINT|THE BEGINNING)\b`)
+
+// commonLine introduces a call which follows a boundary shared with the named parcels
+func commonLine(parcels string) string {
+	if parcels == "" {
+		return ""
+	}
+	return fmt.Sprintf("ALONG A LINE COMMON TO %s, ", strings.ToUpper(parcels))
+}
+
+// AliquotLine is a line of an aliquot part of a public land survey section, ie the north line of the NE 1/4
+type AliquotLine struct {
+	Side    Direction
+	Aliquot string // ie "NE 1/4" or "SW 1/4 OF THE NE 1/4"
+}
+
+// Describe introduces a call which follows the aliquot line. A nil line describes nothing.
+func (al *AliquotLine) Describe() string {
+	if al == nil {
+		return ""
+	}
+	return fmt.Sprintf("ALONG THE %s LINE OF THE %s OF SAID SECTION, ", al.Side.Describe(), strings.ToUpper(al.Aliquot))
+}
+
 //Rotation is a direction of travel along an arc
 type Rotation int
 
@@ -303,6 +753,12 @@ type ArcMete struct {
 	unit         string
 	tangent      float64  // this is the angle tangent to the circle at the start in the direction of trael
 	dir          Rotation // this gives us direction of travel
+	// CommonLine names the parcels sharing this boundary (ie "LOTS 5 AND 6"), if any.
+	CommonLine string
+	// Aliquot is the line of a section subdivision which this boundary follows, if any.
+	Aliquot *AliquotLine
+	// EndMonument is the monument found at the end of the arc, if any, ie A FOUND 1/2" IRON PIN
+	EndMonument string
 }
 
 // NewArcMete creates a curved mete when parameters are known to the caller.
@@ -316,6 +772,22 @@ func NewArcMete(central, rad, tan float64, unit string, rot Rotation) *ArcMete {
 	}
 }
 
+// NewArcMeteFromChord creates a curved mete from the length and bearing of its chord, as angles in radians, and its
+// radius. Of the two arcs subtended by the chord, the one with the smaller central angle is taken.
+func NewArcMeteFromChord(chordLen, chordBearing, radius float64, unit string, rot Rotation) (*ArcMete, error) {
+	if radius <= 0.0 {
+		return nil, fmt.Errorf("radius %.2f is not positive", radius)
+	}
+	if chordLen <= 0.0 {
+		return nil, fmt.Errorf("chord length %.2f is not positive", chordLen)
+	}
+	if chordLen > 2.0*radius {
+		return nil, fmt.Errorf("chord length %.2f exceeds the diameter %.2f", chordLen, 2.0*radius)
+	}
+	central := 2.0 * math.Asin(chordLen/(2.0*radius))
+	return NewArcMete(central, radius, normalizeAngle(chordBearing-float64(rot)*central/2.0), unit, rot), nil
+}
+
 // Tangent is the direction of the arc from its beginning.
 func (am *ArcMete) Tangent() float64 {
 	return am.tangent
@@ -333,8 +805,12 @@ func (am *ArcMete) ChordAngle() float64 {
 
 // Concavity gives the cardinal direction of an angle from the midpoint of the arc to the center of the circle
 func (am *ArcMete) Concavity() Direction {
-	concaveBearing := am.tangent + float64(am.dir)*am.centralAngle/2.0 + float64(am.dir)*math.Pi/4.0
-	return DirectionFromAngle(concaveBearing)
+	return DirectionFromAngle(am.concaveAngle())
+}
+
+// concaveAngle is the angle from the midpoint of the arc to the center of the circle
+func (am *ArcMete) concaveAngle() float64 {
+	return am.ChordAngle() + float64(am.dir)*math.Pi/2.0 // the center lies square to the midpoint tangent
 }
 
 // ArcLength is the traveled distance along the circle.
@@ -344,25 +820,99 @@ func (am *ArcMete) ArcLength() float64 {
 
 // Describe returns a formatted string to be used to describe a mete in a legal description.
 func (am *ArcMete) Describe() string {
-	direction := DirectionFromAngle(am.ChordAngle()).Describe()
-	var centBear Bearing
-	centBear.FromAngle(am.centralAngle)
-	cent := centBear.Describe()
+	return am.DescribeWith(FormatOptions{})
+}
+
+// DescribeWith returns a formatted string describing the mete in the given style. Unless the curve order places it
+// in the preamble, the radius is stated alongside the central angle.
+func (am *ArcMete) DescribeWith(opts FormatOptions) string {
+	direction := opts.direction(am.ChordAngle()).Describe()
+	cent := dmsWith(am.centralAngle, opts.Symbols, opts.secondsPlaces())
+	places := opts.distancePlaces()
 	arclen := am.ArcLength()
-	return fmt.Sprintf("%sERLY ALONG SAID CURVE THROUGH A CENTRAL ANGLE OF %s AN ARC DISTANCE OF %.2f %s", direction, cent, arclen, am.unit)
+	var call string
+	switch opts.CurveOrder {
+	case DeltaRadiusArc:
+		call = fmt.Sprintf("%sERLY ALONG SAID CURVE THROUGH A CENTRAL ANGLE OF %s AND A RADIUS OF %.*f %s AN ARC DISTANCE OF %.*f %s", direction, cent, places, am.radius, am.unit, places, arclen, am.unit)
+	case RadiusDeltaArc:
+		call = fmt.Sprintf("%sERLY ALONG SAID CURVE HAVING A RADIUS OF %.*f %s THROUGH A CENTRAL ANGLE OF %s AN ARC DISTANCE OF %.*f %s", direction, places, am.radius, am.unit, cent, places, arclen, am.unit)
+	default:
+		call = fmt.Sprintf("%sERLY ALONG SAID CURVE THROUGH A CENTRAL ANGLE OF %s AN ARC DISTANCE OF %.*f %s", direction, cent, places, arclen, am.unit)
+	}
+	var chord Bearing
+	chord.FromAngle(am.ChordAngle())
+	switch opts.Chord {
+	case SubtendedChord:
+		call += fmt.Sprintf(", SAID CURVE BEING SUBTENDED BY A CHORD BEARING %s, A DISTANCE OF %.*f %s", opts.bearing(&chord), places, am.ChordLength(), am.unit)
+	case ChordBears:
+		call += fmt.Sprintf(", THE CHORD OF WHICH BEARS %s A DISTANCE OF %.*f %s", opts.bearing(&chord), places, am.ChordLength(), am.unit)
+	}
+	return commonLine(am.CommonLine) + am.Aliquot.Describe() + call
 }
 
 // Preamble returns a formatted string which describes the mete with respect to the previous (ie, tangency and concavity)
 func (am *ArcMete) Preamble(prevAngle float64) string {
-	conc := am.Concavity().Describe()
-	if prevAngle == am.tangent {
-		return fmt.Sprintf("THE BEGINNING OF A CURVE CONCAVE %sERLY, SAID CURVE HAS A RADIUS OF %.2f %s", conc, am.radius, am.unit)
+	return am.PreambleWith(prevAngle, FormatOptions{})
+}
+
+// PreambleWith describes the mete with respect to the previous in the given style
+func (am *ArcMete) PreambleWith(prevAngle float64, opts FormatOptions) string {
+	conc := opts.direction(am.concaveAngle()).Describe()
+	radius := ""
+	if opts.CurveOrder == RadiusInPreamble {
+		radius = fmt.Sprintf(", SAID CURVE HAS A RADIUS OF %.*f %s", opts.distancePlaces(), am.radius, am.unit)
+	}
+	if tangent(prevAngle, am.tangent) {
+		return fmt.Sprintf("THE BEGINNING OF A CURVE CONCAVE %sERLY%s", conc, radius)
 	}
-	radial := am.tangent + float64(am.dir)*math.Pi/4.0 + math.Pi/2.0 // rotate 90degrees and calculate the opposite angle
 	var b Bearing
-	b.FromAngle(radial)
-	radBear := b.Describe()
-	return fmt.Sprintf("THE BEGINNING OF A NON-TANGENT CURVE CONCAVE %sERLY, SAID CURVE HAS A RADIUS OF %.2f %s, TO WHICH A RADIAL LINE BEARS %s", conc, am.radius, am.unit, radBear)
+	b.FromAngle(am.radial())
+	radBear := opts.bearing(&b)
+	return fmt.Sprintf("THE BEGINNING OF A NON-TANGENT CURVE CONCAVE %sERLY%s, TO WHICH A RADIAL LINE BEARS %s", conc, radius, radBear)
+}
+
+// CompoundCurve creates an arc which continues from the end of this one in the same rotation with a different radius
+func (am *ArcMete) CompoundCurve(central, rad float64) *ArcMete {
+	return NewArcMete(central, rad, endTangent(am), am.unit, am.dir)
+}
+
+// ReverseCurve creates an arc which continues from the end of this one turning in the opposite rotation
+func (am *ArcMete) ReverseCurve(central, rad float64) *ArcMete {
+	return NewArcMete(central, rad, endTangent(am), am.unit, -am.dir)
+}
+
+// joinPreamble describes the start of the arc where it joins the arc prev tangentially as a compound or reverse
+// curve. It reports false when the arcs are not tangent or are simply one curve continued.
+func (am *ArcMete) joinPreamble(prev *ArcMete, opts FormatOptions) (string, bool) {
+	if !tangent(endTangent(prev), am.tangent) {
+		return "", false
+	}
+	var kind string
+	switch {
+	case prev.dir != am.dir:
+		kind = "REVERSE"
+	case prev.radius != am.radius:
+		kind = "COMPOUND"
+	default:
+		return "", false
+	}
+	radius := ""
+	if opts.CurveOrder == RadiusInPreamble {
+		radius = fmt.Sprintf(", SAID CURVE HAS A RADIUS OF %.*f %s", opts.distancePlaces(), am.radius, am.unit)
+	}
+	return fmt.Sprintf("THE BEGINNING OF A %s CURVE CONCAVE %sERLY%s", kind, opts.direction(am.concaveAngle()).Describe(), radius), true
+}
+
+// RadiusPoint states the coordinates of the radius point of the arc when travel begins at start, given as (easting,
+// northing)
+func (am *ArcMete) RadiusPoint(start [2]float64) string {
+	center := am.Center(start)
+	return fmt.Sprintf("THE RADIUS POINT OF SAID CURVE BEING N %.2f, E %.2f", center[1], center[0])
+}
+
+// radial is the angle of the radial line from the radius point to the start of the arc
+func (am *ArcMete) radial() float64 {
+	return am.tangent - float64(am.dir)*math.Pi/2.0
 }
 
 // Description contains all the information necessary to build a complete legal description of a bounded area
@@ -379,24 +929,654 @@ type Description struct {
 	Area         float64
 	Unit         string
 	Metes        []Mete
+	// StartCoordinates places the point of beginning at an absolute (easting, northing) coordinate instead of a lot corner.
+	StartCoordinates *[2]float64
+	// StartDescription describes a point of beginning which is not a corner of the parcel, ie "A POINT ON THE NORTH
+	// LINE OF SAID LOT". When set it is used in place of the corner given by Start.
+	StartDescription string
+	// PreTied begins the description at a previously established point of beginning rather than a lot corner.
+	PreTied bool
+	// ConvergenceAngle is the angle in radians between grid north and geodetic north at the parcel. Bearings are not
+	// adjusted by it; they are presumed to already be grid bearings, and a note stating the convergence is added.
+	ConvergenceAngle float64
+	// ShowClosing appends the computed closing course to the description for verification. It is not part of the legal.
+	ShowClosing bool
+	// Format is the style in which the description is written
+	Format FormatOptions
+	// Width and Depth are the overall dimensions of the lot, recited after the metes when set
+	Width float64
+	Depth float64
+	// Centerline describes a strip of land of StripWidth lying equally on each side of the metes, such as an
+	// easement, rather than the parcel they enclose. The metes end at the point of termination, and an area which is
+	// not stated is computed as the width times the length of the centerline.
+	Centerline bool
+	StripWidth float64
+	// BeginningTies fix the point of beginning by its position relative to found monuments
+	BeginningTies []Tie
+	// Section, Township, Range and Meridian locate an unplatted tract in the Public Land Survey System, ie section 12,
+	// township "2 NORTH", range "11 WEST" of the "FIFTH PRINCIPAL MERIDIAN". AliquotPart is the part of the section
+	// the tract lies in, ie "NE 1/4". They are only used when there is no subdivision.
+	Section     string
+	Township    string
+	Range       string
+	Meridian    string
+	AliquotPart string
+	// Exceptions are tracts lying within the parcel which are excluded from it, each described after the parcel as
+	// LESS AND EXCEPT THE FOLLOWING DESCRIBED TRACT
+	Exceptions []*Description
+	// DeductExceptions reduces the stated area of the parcel by the computed area of each of its exceptions
+	DeductExceptions bool
+	// Strict validates the description before it is described, failing rather than writing a malformed description
+	Strict bool
+}
+
+// Tie locates a point by its bearing and distance from a monument
+type Tie struct {
+	Bearing  Bearing // the direction from the monument to the point
+	Distance float64
+	Unit     string
+	Monument string // ie "1/2 INCH IRON PIN"
+}
+
+// Describe states the position of the tied point relative to the monument
+func (t *Tie) Describe() string {
+	return fmt.Sprintf("%s, %.2f %s FROM A FOUND %s", t.Bearing.Describe(), t.Distance, strings.ToUpper(t.Unit), strings.ToUpper(t.Monument))
+}
+
+// Beginning describes the point at which the description begins. A stated coordinate takes precedence over a
+// pre-tied point of beginning, and both take precedence over a described point or the cardinal corner given by
+// Start. Any ties to monuments follow the point of beginning, which is reached by the commencement tie when the
+// description commences elsewhere.
+func (d *Description) Beginning() string {
+	if d.Commencement {
+		return d.beginning()
+	}
+	return d.beginning() + d.tiesClause()
+}
+
+// tiesClause fixes the point of beginning by its ties to monuments, ie ", SAID POINT OF BEGINNING BEING ...". It is
+// empty when there are no ties.
+func (d *Description) tiesClause() string {
+	if len(d.BeginningTies) == 0 {
+		return ""
+	}
+	ties := make([]string, len(d.BeginningTies))
+	for i := range d.BeginningTies {
+		ties[i] = d.BeginningTies[i].Describe()
+	}
+	return ", SAID POINT OF BEGINNING BEING " + strings.Join(ties, " AND ")
+}
+
+// beginning describes the point at which the description begins, without ties
+func (d *Description) beginning() string {
+	verb := "BEGINNING"
+	if d.Commencement {
+		verb = "COMMENCING"
+	}
+	switch {
+	case d.StartCoordinates != nil:
+		start, err := d.commencementPoint(*d.StartCoordinates)
+		if err != nil {
+			start = *d.StartCoordinates
+		}
+		return fmt.Sprintf("%s AT A POINT HAVING COORDINATES N %.2f E %.2f", verb, start[1], start[0])
+	case d.PreTied:
+		return "BEGINNING AT THE POINT OF BEGINNING"
+	}
+	if point := strings.TrimSpace(d.StartDescription); point != "" {
+		return fmt.Sprintf("%s AT %s", verb, strings.ToUpper(point))
+	}
+	// the parcel is named as in the header, which states a lot only as part of a subdivision
+	parcel := "LOT"
+	switch {
+	case d.Subdivision != "":
+		if d.Lot != "" {
+			parcel += " " + d.Lot
+		}
+	case d.AliquotPart != "":
+		parcel = d.AliquotPart
+	case d.Section != "":
+		parcel = "SECTION " + d.Section
+	default:
+		parcel = "TRACT"
+	}
+	return fmt.Sprintf("%s AT THE %s CORNER OF SAID %s", verb, d.Start.Describe(), parcel)
+}
+
+// commencementPoint is the point at which the description begins, given the point of beginning. When the description
+// commences elsewhere, the commencement tie leads from it to the point of beginning.
+func (d *Description) commencementPoint(beginning [2]float64) ([2]float64, error) {
+	if !d.Commencement || len(d.Metes) == 0 {
+		return beginning, nil
+	}
+	dx, dy, err := closure([2]float64{0.0, 0.0}, d.Metes[:1])
+	if err != nil {
+		return beginning, fmt.Errorf("commencement tie: %v", err)
+	}
+	return [2]float64{beginning[0] + dx, beginning[1] + dy}, nil
+}
+
+// ConvergenceNote states that bearings are grid bearings and the convergence angle between grid and geodetic north.
+// It is empty when no convergence angle is set.
+func (d *Description) ConvergenceNote() string {
+	if d.ConvergenceAngle == 0.0 {
+		return ""
+	}
+	return fmt.Sprintf("(GRID BEARINGS; CONVERGENCE ANGLE %s APPLIED)", dms(d.ConvergenceAngle))
+}
+
+// DimensionRecital states the overall width and depth of the lot. It is empty unless both are set.
+func (d *Description) DimensionRecital() string {
+	if d.Width == 0.0 || d.Depth == 0.0 || d.Centerline {
+		return ""
+	}
+	unit := "FEET"
+	if u, err := commonUnit(d.boundary()); err == nil && u != "" {
+		unit = u
+	}
+	return fmt.Sprintf("SAID LOT BEING %.2f %s IN WIDTH AND %.2f %s IN DEPTH.", d.Width, unit, d.Depth, unit)
+}
+
+// Perimeter is the total length of the boundary along its lines and arcs, in the unit of its first call. Calls in
+// other units are converted to it.
+func (d *Description) Perimeter() (float64, string, error) {
+	metes := d.boundary()
+	unit, err := commonUnit(metes)
+	if err != nil {
+		return 0.0, "", err
+	}
+	total, err := Perimeter(metes)
+	if err != nil {
+		return 0.0, "", err
+	}
+	return total, unit, nil
+}
+
+// PerimeterRecital states the perimeter of the parcel. It is empty unless the format states the perimeter, and for a
+// centerline, which bounds no parcel.
+func (d *Description) PerimeterRecital() string {
+	if !d.Format.StatePerimeter || d.Centerline {
+		return ""
+	}
+	perimeter, unit, err := d.Perimeter()
+	if err != nil {
+		return ""
+	}
+	if unit == "" {
+		unit = "FEET"
+	}
+	return fmt.Sprintf("SAID TRACT HAVING A PERIMETER OF %.*f %s.", d.Format.distancePlaces(), perimeter, unit)
+}
+
+// acreFractions are the fractions of an acre which are written out in words
+var acreFractions = []struct {
+	value float64
+	words string
+}{
+	{0.125, "ONE-EIGHTH"},
+	{0.25, "ONE-QUARTER"},
+	{0.375, "THREE-EIGHTHS"},
+	{0.5, "ONE-HALF"},
+	{0.625, "FIVE-EIGHTHS"},
+	{0.75, "THREE-QUARTERS"},
+	{0.875, "SEVEN-EIGHTHS"},
+}
+
+// AreaText states the area and its unit. Common fractions of an acre are written out in words with the numeral
+// following in parentheses, ie ONE-QUARTER (0.25) ACRE. When the format lists area units, the area is stated in each
+// of them instead, and it is an error for the area not to convert to them.
+func (d *Description) AreaText() (string, error) {
+	if len(d.Format.AreaUnits) > 0 {
+		return d.areaInUnits()
+	}
+	if unit := strings.ToUpper(strings.TrimSpace(d.Unit)); unit == "ACRE" || unit == "ACRES" {
+		for _, f := range acreFractions {
+			if math.Abs(d.Area-f.value) < 1e-9 {
+				return fmt.Sprintf("%s (%s) ACRE", f.words, strconv.FormatFloat(d.Area, 'f', -1, 64)), nil
+			}
+		}
+	}
+	if d.Format.Precision != nil {
+		return fmt.Sprintf("%.*f %s", d.Format.Precision.Area, d.Area, d.Unit), nil
+	}
+	return fmt.Sprintf("%v %s", d.Area, d.Unit), nil
+}
+
+// areaInUnits states the area in each of the units listed by the format, to the area precision of the format when
+// it has one
+func (d *Description) areaInUnits() (string, error) {
+	places := -1
+	if d.Format.Precision != nil {
+		places = d.Format.Precision.Area
+	}
+	areas := make([]string, len(d.Format.AreaUnits))
+	for i, unit := range d.Format.AreaUnits {
+		area, err := convertArea(d.Area, d.Unit, unit, places)
+		if err != nil {
+			return "", err
+		}
+		areas[i] = area
+	}
+	if len(areas) == 1 {
+		return areas[0], nil
+	}
+	return fmt.Sprintf("%s (%s)", areas[0], strings.Join(areas[1:], " / ")), nil
+}
+
+// Connector is the word introducing the call at index i of the metes. This is "THENCE" unless the call is the first
+// boundary call and the format gives a different connector for it.
+func (d *Description) Connector(i int) string {
+	first := 0
+	if d.Commencement {
+		first = 1
+	}
+	if i == first && d.Format.FirstCallConnector != nil {
+		return *d.Format.FirstCallConnector
+	}
+	return "THENCE"
+}
+
+// PreviousTangent is the direction of travel at the end of the call before the one at index i of the metes
+func (d *Description) PreviousTangent(i int) float64 {
+	if i < 1 || i > len(d.Metes) {
+		return 0.0
+	}
+	return endTangent(d.Metes[i-1])
+}
+
+// Preamble describes the point reached by the call before the one at index i of the metes, given the tangent at
+// the end of that call. An arc joining the arc before it is described as a compound or reverse curve. When the
+// description commences elsewhere, the commencement tie ends at the point of beginning. A monument found at the point
+// is named in place of the tangency of a line, or ahead of the beginning of a curve.
+func (d *Description) Preamble(i int, prevTan float64) string {
+	preamble := preambleWith(d.Metes[i], prevTan, d.Format)
+	if arc, ok := d.Metes[i].(*ArcMete); ok && i > 0 {
+		if prev, ok := d.Metes[i-1].(*ArcMete); ok {
+			if joined, ok := arc.joinPreamble(prev, d.Format); ok {
+				preamble = joined
+			}
+		}
+	}
+	point := ""
+	if i > 0 {
+		point = endMonument(d.Metes[i-1])
+	}
+	if d.Commencement && i == 1 {
+		point = withPointOfBeginning(point) + d.tiesClause()
+	}
+	if point == "" {
+		return preamble
+	}
+	if _, ok := d.Metes[i].(*ArcMete); ok {
+		return point + ", SAID POINT BEING " + preamble
+	}
+	return point
+}
+
+// Ending describes the point reached by the last call, which is the point of beginning
+func (d *Description) Ending() string {
+	if d.Centerline {
+		if len(d.Metes) > 0 {
+			if monument := endMonument(d.Metes[len(d.Metes)-1]); monument != "" {
+				return monument + ", THE POINT OF TERMINATION"
+			}
+		}
+		return "THE POINT OF TERMINATION"
+	}
+	if len(d.Metes) == 0 {
+		return "THE POINT OF BEGINNING"
+	}
+	return withPointOfBeginning(endMonument(d.Metes[len(d.Metes)-1]))
+}
+
+// CenterlinePreamble introduces the centerline of a strip by the width of the strip, ie A 20-FOOT WIDE EASEMENT LYING
+// 10 FEET ON EACH SIDE OF THE FOLLOWING DESCRIBED CENTERLINE. It is empty unless the description is of a centerline.
+func (d *Description) CenterlinePreamble() string {
+	if !d.Centerline {
+		return ""
+	}
+	unit := "FEET"
+	if u, err := commonUnit(d.boundary()); err == nil && u != "" {
+		unit = strings.ToUpper(u)
+	}
+	kind := strings.ToUpper(strings.TrimSpace(d.Kind))
+	if kind == "" {
+		kind = "EASEMENT"
+	}
+	width := strconv.FormatFloat(d.StripWidth, 'f', -1, 64)
+	half := strconv.FormatFloat(d.StripWidth/2.0, 'f', -1, 64)
+	return fmt.Sprintf("A %s-%s WIDE %s LYING %s %s ON EACH SIDE OF THE FOLLOWING DESCRIBED CENTERLINE:", width, singularUnit(unit), kind, half, unit)
 }
 
+// singularUnit is the singular of a unit of length, as used when a length describes something, ie a 20-FOOT WIDE strip
+func singularUnit(unit string) string {
+	switch {
+	case strings.HasSuffix(unit, "FEET"):
+		return strings.TrimSuffix(unit, "FEET") + "FOOT"
+	case strings.HasSuffix(unit, "S"):
+		return strings.TrimSuffix(unit, "S")
+	}
+	return unit
+}
+
+// centerlineArea is the area of a centerline strip, being its width times the length of the centerline, in square
+// units of the length of the centerline, along with that unit of length
+func (d *Description) centerlineArea() (float64, string, error) {
+	metes := d.boundary()
+	unit, err := commonUnit(metes)
+	if err != nil {
+		return 0.0, "", err
+	}
+	length, err := Perimeter(metes)
+	if err != nil {
+		return 0.0, "", err
+	}
+	return d.StripWidth * length, strings.ToUpper(unit), nil
+}
+
+// withPointOfBeginning names a point as the point of beginning, after the monument found there if there is one
+func withPointOfBeginning(monument string) string {
+	if monument == "" {
+		return "THE POINT OF BEGINNING"
+	}
+	return monument + ", THE POINT OF BEGINNING"
+}
+
+// endMonument is the monument found at the end of a mete, if any
+func endMonument(m Mete) string {
+	switch mete := m.(type) {
+	case *LinearMete:
+		return strings.ToUpper(mete.EndMonument)
+	case *ArcMete:
+		return strings.ToUpper(mete.EndMonument)
+	case *MeanderSegment:
+		if len(mete.Metes) > 0 {
+			return endMonument(mete.Metes[len(mete.Metes)-1])
+		}
+	}
+	return ""
+}
+
+// clockwise is whether the boundary runs clockwise around the parcel. A boundary which encloses no area, or whose
+// calls cannot be traversed, is taken to run clockwise.
+func (d *Description) clockwise() bool {
+	area, err := signedArea([2]float64{0.0, 0.0}, d.boundary())
+	return err != nil || area <= 0.0
+}
+
+// vertex is the point at which the call at index i of the metes begins, given that the start coordinates are those of
+// the point of beginning
+func (d *Description) vertex(i int) ([2]float64, error) {
+	start, err := d.commencementPoint(*d.StartCoordinates)
+	if err != nil {
+		return start, err
+	}
+	points, err := Coordinates(start, d.Metes[:i])
+	if err != nil {
+		return start, err
+	}
+	return points[len(points)-1], nil
+}
+
+// Call is the text of the call at index i of the metes, after any transform given by the format. It is an error for
+// the radius point of a curve to be stated when the curve can not be located.
+func (d *Description) Call(i int) (string, error) {
+	text := describeWith(d.Metes[i], d.Format)
+	first := 0
+	if d.Commencement {
+		first = 1
+	}
+	if line, ok := d.Metes[i].(*LinearMete); ok && d.Format.Courses == InteriorAngles && i > first {
+		text = line.describeTurned(d.PreviousTangent(i), d.clockwise(), d.Format)
+	}
+	if arc, ok := d.Metes[i].(*ArcMete); ok && d.Format.RadiusPoint && d.StartCoordinates != nil {
+		start, err := d.vertex(i)
+		if err != nil {
+			return "", fmt.Errorf("mete %d: %v", i+1, err)
+		}
+		text += ", " + arc.RadiusPoint(start)
+	}
+	if d.Format.CallTransform != nil {
+		text = d.Format.CallTransform(i, text)
+	}
+	return text, nil
+}
+
+// DefaultTemplate is the layout of a description, which may be copied and altered to change its wording. It is
+// executed with the Description, so its methods such as Beginning, Preamble, Call and AreaText may be used.
+const DefaultTemplate = `{{.Kind}} DESCRIPTION:
+
+{{if ne .Subdivision ""}}A PART OF {{if ne .Lot ""}}LOT {{.Lot}}, {{end}}{{if ne .Block ""}}BLOCK {{.Block}}, {{end}}{{.Subdivision}} TO {{if ne .City ""}}THE CITY OF {{.City}}, {{end}}{{else if ne .Section ""}}A PART OF {{if ne .AliquotPart ""}}THE {{.AliquotPart}} OF {{end}}SECTION {{.Section}}, {{if ne .Township ""}}TOWNSHIP {{.Township}}, {{end}}{{if ne .Range ""}}RANGE {{.Range}}{{if ne .Meridian ""}} OF THE {{.Meridian}}{{end}}, {{end}}{{if ne .City ""}}IN THE CITY OF {{.City}}, {{end}}{{else}}A TRACT OF LAND {{if or .City .County .State}}LYING IN {{end}}{{if ne .City ""}}THE CITY OF {{.City}}, {{end}}{{end}}{{if ne .County ""}}{{.County}} COUNTY, {{end}}{{if ne .State ""}}{{.State}}, {{end}}BEING MORE PARTICULARLY DESCRIBED AS FOLLOWS:
+{{with .ConvergenceNote}}{{.}}
+{{end}}{{template "tract" .}}{{define "tract"}}{{with .CenterlinePreamble}}{{.}} {{end}}{{.Beginning}}; {{range $i, $m := .Metes}}{{if ne $i 0}}TO {{$.Preamble $i ($.PreviousTangent $i)}}; {{$.Format.CallDelimiter}}{{end}}{{with $.Connector $i}}{{.}} {{end}}{{$.Call $i}} {{end}}TO {{.Ending}}, {{.Format.Containing}} {{.AreaText}} MORE OR LESS{{with .CalculatedAreaNote}} {{.}}{{end}}.{{with .DimensionRecital}} {{.}}{{end}}{{with .PerimeterRecital}} {{.}}{{end}}{{range .Exceptions}} LESS AND EXCEPT THE FOLLOWING DESCRIBED TRACT: {{template "tract" .}}{{end}}{{end}}`
+
+// TemplateFuncs are the functions available to a description template. Describe, Preamble and Tangent describe a
+// mete, describe it with respect to the tangent of the mete before it, and give its tangent.
+func TemplateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"Describe": func(m Mete) string { return m.Describe() },
+		"Preamble": func(m Mete, prevTan float64) string { return m.Preamble(prevTan) },
+		"Tangent":  func(m Mete) float64 { return m.Tangent() },
+	}
+}
+
+// descriptionTemplate lays out a description. It is parsed once and shared, as a template may be executed
+// concurrently.
+var descriptionTemplate = template.Must(template.New("description").Funcs(TemplateFuncs()).Parse(DefaultTemplate))
+
 // Describe creates a formatted legal description of a lot
 func (d *Description) Describe() (string, error) {
-	var result bytes.Buffer
-	tmpl := `{{.Kind}} DESCRIPTION:
+	var result strings.Builder
+	if _, err := d.WriteTo(&result); err != nil {
+		return "", err
+	}
+	return result.String(), nil
+}
+
+// DescribeWith creates a legal description of a lot laid out by a custom template, such as an altered copy of
+// DefaultTemplate parsed with TemplateFuncs.
+func (d *Description) DescribeWith(tmpl *template.Template) (string, error) {
+	var result strings.Builder
+	if _, err := d.write(&result, tmpl); err != nil {
+		return "", err
+	}
+	return result.String(), nil
+}
+
+// WriteTo writes the formatted legal description of a lot to w as each call is rendered, so that a description of
+// many calls is never held in memory whole. It returns the number of bytes written.
+func (d *Description) WriteTo(w io.Writer) (int64, error) {
+	return d.write(w, descriptionTemplate)
+}
+
+// write writes the legal description of a lot laid out by the template to w in the case of the format. Only a
+// description in capitals is streamed, as any other case depends on the whole text.
+func (d *Description) write(w io.Writer, tmpl *template.Template) (int64, error) {
+	if d.Format.Case == UpperCase {
+		return d.render(w, tmpl)
+	}
+	var upper strings.Builder
+	if _, err := d.render(&upper, tmpl); err != nil {
+		return 0, err
+	}
+	n, err := io.WriteString(w, d.recase(upper.String()))
+	return int64(n), err
+}
+
+// render writes the legal description of a lot laid out by the template to w in capitals
+func (d *Description) render(w io.Writer, tmpl *template.Template) (int64, error) {
+	out := &countingWriter{w: w}
+	if d.Strict {
+		if err := d.Validate(); err != nil {
+			return out.n, err
+		}
+	}
+	if d.Format.RadiusPoint && d.StartCoordinates == nil {
+		return out.n, fmt.Errorf("radius points require start coordinates")
+	}
+	if d.StartCoordinates != nil {
+		if _, err := d.commencementPoint(*d.StartCoordinates); err != nil {
+			return out.n, err
+		}
+	}
+	if len(d.Format.AreaUnits) > 0 {
+		if _, err := d.areaInUnits(); err != nil {
+			return out.n, err
+		}
+	}
+	if d.Format.StatePerimeter {
+		if _, _, err := d.Perimeter(); err != nil {
+			return out.n, err
+		}
+	}
+	parcel := d
+	if d.DeductExceptions && len(d.Exceptions) > 0 {
+		net, err := d.netArea()
+		if err != nil {
+			return out.n, err
+		}
+		deducted := *d
+		deducted.Area = net
+		parcel = &deducted
+	}
+	if d.Centerline && d.Area == 0.0 {
+		area, unit, err := d.centerlineArea()
+		if err != nil {
+			return out.n, err
+		}
+		strip := *parcel
+		strip.Area, strip.Unit = area, "SQUARE "+unit
+		parcel = &strip
+	}
+	if len(d.Exceptions) > 0 {
+		excepting := *parcel
+		excepting.Exceptions = d.formattedExceptions()
+		parcel = &excepting
+	}
+	if d.Format.CalculatedAreaTolerance > 0.0 {
+		if _, err := parcel.CalculatedArea(); err != nil {
+			return out.n, err
+		}
+	}
+	err := tmpl.Execute(out, parcel)
+	if err != nil {
+		return out.n, err
+	}
+	if d.ShowClosing && !d.Centerline {
+		closing, err := d.ClosingLine()
+		if err != nil {
+			return out.n, err
+		}
+		_, err = io.WriteString(out, "\n\n"+closing)
+		if err != nil {
+			return out.n, err
+		}
+	}
+	return out.n, nil
+}
+
+// formattedExceptions are copies of the exceptions of the description written in its format, so that the whole
+// description is written in one style
+func (d *Description) formattedExceptions() []*Description {
+	exceptions := make([]*Description, len(d.Exceptions))
+	for i, e := range d.Exceptions {
+		exception := *e
+		exception.Format = d.Format
+		exception.Exceptions = exception.formattedExceptions()
+		exceptions[i] = &exception
+	}
+	return exceptions
+}
+
+// netArea is the stated area of the parcel less the computed area of each of its exceptions, in the unit of the
+// stated area
+func (d *Description) netArea() (float64, error) {
+	net := d.Area
+	for i, e := range d.Exceptions {
+		area, err := d.enclosed(e.boundary())
+		if err != nil {
+			return 0.0, fmt.Errorf("exception %d: %v", i+1, err)
+		}
+		net -= area
+	}
+	return net, nil
+}
 
-A PART OF {{if ne .Lot ""}}LOT {{.Lot}}, {{end}}{{if ne .Block ""}}BLOCK {{.Block}}, {{end}}{{.Subdivision}} TO {{if ne .City ""}}THE CITY OF {{.City}}, {{end}}{{.County}} COUNTY, {{.State}}, BEING MORE PARTICULARLY DESCRIBED AS FOLLOWS:
-{{if eq .Commencement true}}COMMENCING {{else}}BEGINNING {{end}} AT THE {{.Start.Describe}} CORNER OF SAID LOT{{if ne .Lot ""}} {{.Lot}}{{end}}; {{$prevtan := 0.0}}{{range $i, $m := .Metes}}{{if ne $i 0}}TO {{$m.Preamble $prevtan}}; {{end}}THENCE {{$m.Describe}} {{end}}TO THE POINT OF BEGINNING, CONTAINING {{.Area}} {{.Unit}} MORE OR LESS.`
-	t := template.Must(template.New("description").Parse(tmpl))
-	err := t.Execute(&result, d)
+// CalculatedArea is the area computed by AreaCheck. When exceptions are deducted from the stated area, they are
+// deducted from the calculated area too.
+func (d *Description) CalculatedArea() (float64, error) {
+	area, _, _, err := d.AreaCheck(0.0)
+	if err != nil {
+		return 0.0, err
+	}
+	if d.DeductExceptions && !d.Centerline {
+		for i, e := range d.Exceptions {
+			excepted, err := d.enclosed(e.boundary())
+			if err != nil {
+				return 0.0, fmt.Errorf("exception %d: %v", i+1, err)
+			}
+			area -= excepted
+		}
+	}
+	return area, nil
+}
+
+// CalculatedAreaNote states the calculated area when it differs from the stated area by more than the tolerance of
+// the format. It is empty when they agree or no tolerance is set.
+func (d *Description) CalculatedAreaNote() string {
+	if d.Format.CalculatedAreaTolerance <= 0.0 {
+		return ""
+	}
+	calculated, err := d.CalculatedArea()
+	if err != nil || math.Abs(d.Area-calculated) <= d.Format.CalculatedAreaTolerance {
+		return ""
+	}
+	places := 2
+	if d.Format.Precision != nil {
+		places = d.Format.Precision.Area
+	}
+	return fmt.Sprintf("(CALCULATED AREA: %.*f %s)", places, calculated, strings.ToUpper(strings.TrimSpace(d.Unit)))
+}
+
+// enclosed is the area enclosed by the metes in the unit of the stated area
+func (d *Description) enclosed(metes []Mete) (float64, error) {
+	area, err := Area(metes)
+	if err != nil {
+		return 0.0, err
+	}
+	unit, err := commonUnit(metes)
+	if err != nil {
+		return 0.0, err
+	}
+	return d.inStatedUnit(area, unit)
+}
+
+// inStatedUnit converts an area in square units of length to the unit of the stated area. An area is left as it is
+// when no unit is stated.
+func (d *Description) inStatedUnit(area float64, unit string) (float64, error) {
+	if strings.TrimSpace(d.Unit) == "" {
+		return area, nil
+	}
+	return toAreaUnit(area, "SQUARE "+unit, d.Unit)
+}
+
+// ClosingLine states the course from the end of the final call back to the point of beginning. It is verification
+// metadata for reviewers and is marked as not being part of the legal description. A centerline has no closing line.
+func (d *Description) ClosingLine() (string, error) {
+	if d.Centerline {
+		return "", fmt.Errorf("a centerline does not close")
+	}
+	metes := d.boundary()
+	dx, dy, err := closure([2]float64{0.0, 0.0}, metes)
 	if err != nil {
 		return "", err
 	}
-	legal := result.String()
-	lastSemi := strings.LastIndex(legal, ";")
-	if lastSemi != -1 {
-		legal = legal[:lastSemi] + legal[lastSemi+1:]
+	// the closure is computed in the unit of the first call which has one
+	_, unit, err := toCommonUnit(metes)
+	if err != nil {
+		return "", err
 	}
-	return legal, nil
+	var b Bearing
+	b.FromAngle(math.Atan2(dx, dy))
+	return fmt.Sprintf("[VERIFICATION ONLY - NOT PART OF THE LEGAL DESCRIPTION] CLOSING: %s, %.2f %s", b.Describe(), math.Hypot(dx, dy), unit), nil
 }
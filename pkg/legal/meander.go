@@ -0,0 +1,66 @@
+package legal
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MeanderSegment is a series of courses following an irregular boundary such as a creek or shoreline. The courses are
+// described together as the chords of the meander, introduced by the feature they follow.
+type MeanderSegment struct {
+	Feature string
+	Metes   []Mete
+}
+
+// NewMeanderSegment groups courses along a named feature
+func NewMeanderSegment(feature string, metes ...Mete) *MeanderSegment {
+	return &MeanderSegment{Feature: feature, Metes: metes}
+}
+
+// Tangent is the direction of the first course of the meander
+func (ms *MeanderSegment) Tangent() float64 {
+	if len(ms.Metes) == 0 {
+		return 0.0
+	}
+	return ms.Metes[0].Tangent()
+}
+
+// Describe lists the courses of the meander following a statement of how many there are
+func (ms *MeanderSegment) Describe() string {
+	return ms.DescribeWith(FormatOptions{})
+}
+
+// DescribeWith lists the courses of the meander in the given style
+func (ms *MeanderSegment) DescribeWith(opts FormatOptions) string {
+	courses := make([]string, len(ms.Metes))
+	for i, m := range ms.Metes {
+		courses[i] = fmt.Sprintf("(%d) %s", i+1, describeWith(m, opts))
+	}
+	return fmt.Sprintf("THE FOLLOWING %d COURSES ALONG %s: %s", len(ms.Metes), strings.ToUpper(ms.Feature), strings.Join(courses, ", "))
+}
+
+// Preamble describes the start of the meander with respect to the previous mete
+func (ms *MeanderSegment) Preamble(prevTan float64) string {
+	return ms.PreambleWith(prevTan, FormatOptions{})
+}
+
+// PreambleWith describes the start of the meander with respect to the previous mete in the given style
+func (ms *MeanderSegment) PreambleWith(prevTan float64, opts FormatOptions) string {
+	if len(ms.Metes) == 0 {
+		return "A POINT"
+	}
+	return preambleWith(ms.Metes[0], prevTan, opts)
+}
+
+// flatten replaces any meanders with the courses they group
+func flatten(metes []Mete) []Mete {
+	var flat []Mete
+	for _, m := range metes {
+		if ms, ok := m.(*MeanderSegment); ok {
+			flat = append(flat, flatten(ms.Metes)...)
+			continue
+		}
+		flat = append(flat, m)
+	}
+	return flat
+}
@@ -0,0 +1,89 @@
+package legal
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// NamedPoint is a point of a survey point file, identified by its name or number
+type NamedPoint struct {
+	Point
+	Name        string
+	Description string
+}
+
+// ParsePoints reads a point file of CSV rows given as
+//
+//	point,northing,easting[,description]
+//
+// A first row whose northing is not a number is taken to be a header and skipped. Point names must be unique.
+func ParsePoints(r io.Reader) ([]NamedPoint, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	var points []NamedPoint
+	seen := make(map[string]bool)
+	for row := 1; ; row++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := reader.FieldPos(0)
+		if len(record) < 3 {
+			return nil, &ParseError{Line: line, Field: "point", Token: strings.Join(record, ","), Err: fmt.Errorf("expected point, northing and easting")}
+		}
+		name := strings.TrimSpace(record[0])
+		northing, err := strconv.ParseFloat(strings.TrimSpace(record[1]), 64)
+		if err != nil {
+			if row == 1 {
+				continue // header
+			}
+			return nil, &ParseError{Line: line, Field: "northing", Token: record[1], Err: err}
+		}
+		easting, err := strconv.ParseFloat(strings.TrimSpace(record[2]), 64)
+		if err != nil {
+			return nil, &ParseError{Line: line, Field: "easting", Token: record[2], Err: err}
+		}
+		if seen[name] {
+			return nil, &ParseError{Line: line, Field: "point", Token: name, Err: fmt.Errorf("duplicate point")}
+		}
+		seen[name] = true
+		p := NamedPoint{Point: Point{X: easting, Y: northing}, Name: name}
+		if len(record) > 3 {
+			p.Description = strings.TrimSpace(record[3])
+		}
+		points = append(points, p)
+	}
+	return points, nil
+}
+
+// OrderPoints arranges the named points in the order given by their names, as the vertices of a boundary. An empty
+// order keeps the points in the order they were read.
+func OrderPoints(points []NamedPoint, order []string) ([]Point, error) {
+	if len(order) == 0 {
+		ordered := make([]Point, len(points))
+		for i, p := range points {
+			ordered[i] = p.Point
+		}
+		return ordered, nil
+	}
+	byName := make(map[string]Point, len(points))
+	for _, p := range points {
+		byName[p.Name] = p.Point
+	}
+	ordered := make([]Point, len(order))
+	for i, name := range order {
+		p, ok := byName[strings.TrimSpace(name)]
+		if !ok {
+			return nil, fmt.Errorf("no point named %q", name)
+		}
+		ordered[i] = p
+	}
+	return ordered, nil
+}
@@ -0,0 +1,181 @@
+package legal
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	regProseKind      = regexp.MustCompile(`^(.+?) DESCRIPTION:`)
+	regProsePlatted   = regexp.MustCompile(`A PART OF (?:LOT ([^,]+), )?(?:BLOCK ([^,]+), )?(.+?) TO (?:THE CITY OF (.+?), )?(?:(.+?) COUNTY, )?(?:(.+?), )?BEING MORE PARTICULARLY DESCRIBED AS FOLLOWS:`)
+	regProsePLSS      = regexp.MustCompile(`A PART OF (?:THE (.+?) OF )?SECTION ([^,]+), (?:TOWNSHIP ([^,]+), )?(?:RANGE ([^,]+?)(?: OF THE ([^,]+))?, )?(?:IN THE CITY OF (.+?), )?(?:(.+?) COUNTY, )?(?:(.+?), )?BEING MORE PARTICULARLY DESCRIBED AS FOLLOWS:`)
+	regProseUnplatted = regexp.MustCompile(`A TRACT OF LAND (?:LYING IN )?(?:THE CITY OF (.+?), )?(?:(.+?) COUNTY, )?(?:(.+?), )?BEING MORE PARTICULARLY DESCRIBED AS FOLLOWS:`)
+	regProseStart     = regexp.MustCompile(`(BEGINNING|COMMENCING) AT THE ([A-Z]+) CORNER`)
+	regProseArea      = regexp.MustCompile(`POINT OF BEGINNING, .*?\(?(\d{1,3}(?:,\d{3})+(?:\.\d*)?|\d+\.?\d*)\)? ([^(]+?) (?:\(.*?\) )?MORE OR LESS`)
+	regProseRadius    = regexp.MustCompile(`RADIUS OF (\d+\.?\d*)`)
+	regProseConcave   = regexp.MustCompile(`CONCAVE ([A-Z-]+?)ERLY`)
+	regProseRadial    = regexp.MustCompile(`RADIAL LINE BEARS (.+)`)
+)
+
+// headerEnd closes the header which locates the tract
+const headerEnd = "BEING MORE PARTICULARLY DESCRIBED AS FOLLOWS:"
+
+// ParseDescription reads a prose legal description, such as one produced by Describe, back into a Description. The
+// kind, location, point of beginning and area are read from their usual clauses, and each "THENCE" clause becomes a
+// mete. Curves are recovered from their radius, central angle and arc distance, with their direction of travel
+// inferred from their stated concavity and their tangent from the previous call or radial line. A header locating the
+// tract which can not be read is a ParseError, rather than a description missing its location.
+func ParseDescription(text string) (*Description, error) {
+	var d Description
+	if subs := regProseKind.FindStringSubmatch(text); subs != nil {
+		d.Kind = subs[1]
+	}
+	if subs := regProsePLSS.FindStringSubmatch(text); subs != nil {
+		d.AliquotPart, d.Section, d.Township, d.Range, d.Meridian = subs[1], subs[2], subs[3], subs[4], subs[5]
+		d.City, d.County, d.State = subs[6], subs[7], subs[8]
+	} else if subs := regProsePlatted.FindStringSubmatch(text); subs != nil {
+		d.Lot, d.Block, d.Subdivision, d.City, d.County, d.State = subs[1], subs[2], subs[3], subs[4], subs[5], subs[6]
+	} else if subs := regProseUnplatted.FindStringSubmatch(text); subs != nil {
+		d.City, d.County, d.State = subs[1], subs[2], subs[3]
+	} else if idx := strings.Index(text, headerEnd); idx != -1 {
+		start := strings.LastIndex(text[:idx], "\n") + 1
+		return nil, &ParseError{Line: strings.Count(text[:start], "\n") + 1, Field: "header", Token: text[start : idx+len(headerEnd)],
+			Err: fmt.Errorf("the location of the tract could not be read")}
+	}
+	if subs := regProseStart.FindStringSubmatch(text); subs != nil {
+		d.Commencement = subs[1] == "COMMENCING"
+		if start, ok := CornerFromString(subs[2]); ok {
+			d.Start = start
+		}
+	}
+	if subs := regProseArea.FindStringSubmatch(text); subs != nil {
+		// thousands may be grouped, and an area converted into another unit follows in parentheses
+		area, err := strconv.ParseFloat(strings.Replace(subs[1], ",", "", -1), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid area %v", subs[1])
+		}
+		d.Area, d.Unit = area, subs[2]
+	}
+	clauses := strings.Split(text, "THENCE ")
+	if len(clauses) < 2 {
+		return nil, fmt.Errorf("no calls found in description")
+	}
+	for i, call := range clauses[1:] {
+		var mete Mete
+		var err error
+		if strings.Contains(call, "ALONG SAID CURVE") {
+			var prev Mete
+			if len(d.Metes) > 0 {
+				prev = d.Metes[len(d.Metes)-1]
+			}
+			mete, err = parseArcCall(call, clauses[i], prev)
+		} else {
+			mete, err = parseLinearCall(call)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("call %d: %v", i+1, err)
+		}
+		d.Metes = append(d.Metes, mete)
+	}
+	return &d, nil
+}
+
+// parseLinearCall reads the bearing, distance and unit of a straight call from a description
+func parseLinearCall(call string) (*LinearMete, error) {
+	loc := regDescribedDistance.FindStringSubmatchIndex(call)
+	if loc == nil {
+		return nil, fmt.Errorf("no distance in %q", call)
+	}
+	head := call[:loc[0]]
+	if comma := strings.LastIndex(head, ", "); comma != -1 {
+		head = head[comma+2:] // skip any leading clause such as a common line
+	}
+	var b Bearing
+	err := b.FromString(head)
+	if err != nil {
+		return nil, err
+	}
+	dist, err := strconv.ParseFloat(call[loc[2]:loc[3]], 64)
+	if err != nil {
+		return nil, err
+	}
+	mete := NewLinearMete(b.ToAngle(), dist, call[loc[4]:loc[5]])
+	return &mete, nil
+}
+
+// parseArcCall reads a curve from its call and the clause before it, which ends with the preamble of the curve
+func parseArcCall(call, before string, prev Mete) (*ArcMete, error) {
+	subs := regDescribedArc.FindStringSubmatch(call)
+	if subs == nil {
+		return nil, fmt.Errorf("no central angle or arc distance in %q", call)
+	}
+	delta, err := parseDMS(subs[1])
+	if err != nil {
+		return nil, err
+	}
+	arclen, err := strconv.ParseFloat(subs[2], 64)
+	if err != nil {
+		return nil, err
+	}
+	if delta == 0.0 {
+		return nil, fmt.Errorf("zero central angle")
+	}
+	preamble := before
+	if idx := strings.LastIndex(before, "THE BEGINNING OF "); idx != -1 {
+		preamble = before[idx:]
+	}
+	radius := arclen / delta
+	if r := regProseRadius.FindStringSubmatch(call); r != nil {
+		radius, _ = strconv.ParseFloat(r[1], 64)
+	} else if r := regProseRadius.FindStringSubmatch(preamble); r != nil {
+		radius, _ = strconv.ParseFloat(r[1], 64)
+	}
+	unit := ""
+	if fields := strings.Fields(call[strings.Index(call, subs[2])+len(subs[2]):]); len(fields) > 0 {
+		unit = fields[0]
+	}
+	var concave *Direction
+	if c := regProseConcave.FindStringSubmatch(preamble); c != nil {
+		if dir, ok := DirectionFromString(c[1]); ok {
+			concave = &dir
+		}
+	}
+	// each direction of travel gives a tangent, from the radial line or previous call, and so a concavity
+	candidates := []Rotation{Clockwise, CounterClockwise}
+	arcs := make([]*ArcMete, len(candidates))
+	for i, rot := range candidates {
+		tangent := 0.0
+		switch {
+		case regProseRadial.MatchString(preamble):
+			var b Bearing
+			err = b.FromString(regProseRadial.FindStringSubmatch(preamble)[1])
+			if err != nil {
+				return nil, err
+			}
+			tangent = b.ToAngle() + float64(rot)*math.Pi/2.0
+		case prev != nil:
+			tangent = endTangent(prev)
+		}
+		arcs[i] = NewArcMete(delta, radius, tangent, unit, rot)
+	}
+	if concave != nil && angleDiff(arcs[1].Concavity().angle(), concave.angle()) < angleDiff(arcs[0].Concavity().angle(), concave.angle()) {
+		return arcs[1], nil
+	}
+	return arcs[0], nil
+}
+
+// endTangent is the direction of travel at the end of a mete
+func endTangent(m Mete) float64 {
+	switch mete := m.(type) {
+	case *ArcMete:
+		return mete.tangent + float64(mete.dir)*mete.centralAngle
+	case *MeanderSegment:
+		if len(mete.Metes) > 0 {
+			return endTangent(mete.Metes[len(mete.Metes)-1])
+		}
+	}
+	return m.Tangent()
+}
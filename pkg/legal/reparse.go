@@ -0,0 +1,83 @@
+package legal
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	regDescribedDistance = regexp.MustCompile(`A DISTANCE OF (\d+\.?\d*)\s?([A-Za-z]*)`)
+	regDescribedArc      = regexp.MustCompile(`CENTRAL ANGLE OF (.+?) AN ARC DISTANCE OF (\d+\.?\d*)`)
+)
+
+// ReparseDescribe renders a description, parses the bearings and distances of each call back out of the text and
+// confirms that they match the metes which produced it. It is a self-test guarding against output that the parser can
+// not read or that has drifted from the underlying geometry.
+func ReparseDescribe(d Description) error {
+	const (
+		angleTolerance    = 1e-6 // radians, comfortably above rounding to hundredths of a second
+		distanceTolerance = 0.01 // distances are described to the hundredth
+	)
+	text, err := d.Describe()
+	if err != nil {
+		return err
+	}
+	calls := strings.Split(text, "THENCE ")[1:]
+	if len(calls) != len(d.Metes) {
+		return fmt.Errorf("described %d calls for %d metes", len(calls), len(d.Metes))
+	}
+	for i, call := range calls {
+		switch m := d.Metes[i].(type) {
+		case *LinearMete:
+			parsed, err := parseLinearCall(call)
+			if err != nil {
+				return fmt.Errorf("call %d: %v", i+1, err)
+			}
+			if angleDiff(parsed.bearing, m.bearing) > angleTolerance {
+				return fmt.Errorf("call %d: bearing %s does not match mete", i+1, parsed.Describe())
+			}
+			if math.Abs(parsed.distance-m.distance) > distanceTolerance {
+				return fmt.Errorf("call %d: distance %.2f does not match mete distance %f", i+1, parsed.distance, m.distance)
+			}
+		case *ArcMete:
+			subs := regDescribedArc.FindStringSubmatch(call)
+			if subs == nil {
+				return fmt.Errorf("call %d: no central angle or arc distance in %q", i+1, call)
+			}
+			delta, err := parseDMS(subs[1])
+			if err != nil {
+				return fmt.Errorf("call %d: %v", i+1, err)
+			}
+			arclen, err := strconv.ParseFloat(subs[2], 64)
+			if err != nil {
+				return fmt.Errorf("call %d: %v", i+1, err)
+			}
+			if angleDiff(delta, m.centralAngle) > angleTolerance {
+				return fmt.Errorf("call %d: central angle %s does not match mete", i+1, subs[1])
+			}
+			if math.Abs(arclen-m.ArcLength()) > distanceTolerance {
+				return fmt.Errorf("call %d: arc distance %.2f does not match mete arc length %f", i+1, arclen, m.ArcLength())
+			}
+		default:
+			return fmt.Errorf("call %d: unsupported mete type %T", i+1, m)
+		}
+	}
+	return nil
+}
+
+// tangencyTolerance is the largest difference in direction, one second of arc, at which two calls are still described
+// as tangent
+const tangencyTolerance = math.Pi / 648000.0
+
+// tangent reports whether two directions in radians are the same within the tangency tolerance
+func tangent(a, b float64) bool {
+	return angleDiff(a, b) <= tangencyTolerance
+}
+
+// angleDiff is the absolute difference between two angles in radians, ignoring whole turns
+func angleDiff(a, b float64) float64 {
+	return math.Abs(math.Remainder(a-b, 2.0*math.Pi))
+}
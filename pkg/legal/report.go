@@ -0,0 +1,194 @@
+package legal
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ParseOptions controls how a metes and bounds report is read
+type ParseOptions struct {
+	// CommentPrefixes mark lines which are ignored entirely, and trailing comments which are stripped from a line.
+	CommentPrefixes []string
+	// Strict rejects anything which would otherwise be silently fixed, such as bearings without seconds, distances
+	// without units and area lines with an unrecognized unit.
+	Strict bool
+	// CallPrefixes begin the lines which are calls, ie "Course 1:". Empty uses the default prefixes.
+	CallPrefixes []string
+}
+
+// defaultCallPrefixes are the labels AutoCAD gives to calls under its various configurations
+var defaultCallPrefixes = []string{"T", "Course", "Line", "Curve"}
+
+// curvePrefix labels a call along a curve, which must give its radius along with its central angle or length
+const curvePrefix = "CURVE"
+
+// DefaultParseOptions treats shell and C++ style comments as annotations
+var DefaultParseOptions = ParseOptions{
+	CommentPrefixes: []string{"#", "//"},
+}
+
+var regArea = regexp.MustCompile(`(\d+\.?\d*)\s?([A-Za-z ]+)`)
+
+// regKnownArea finds an area stated in one of the areaUnits, so that any text following the unit is not taken to be
+// part of it
+var regKnownArea = regexp.MustCompile(`(?i)(\d+\.?\d*)\s*(SQUARE\s+FEET|SQUARE\s+FOOT|SQUARE\s+METERS|SQUARE\s+METRES|ACRES|ACRE|HECTARES|HECTARE)\b`)
+
+// areaUnits are the units of area recognized by a strict parse
+var areaUnits = []string{"SQUARE FEET", "SQUARE FOOT", "SQUARE METERS", "SQUARE METRES", "ACRES", "ACRE", "HECTARES", "HECTARE"}
+
+// ParseReport reads a 'metes and bounds report' from AutoCAD using the default options. It returns the calls of the
+// report along with the area and area unit it states. A recognized unit of area is given in capitals, ie SQUARE FEET.
+func ParseReport(report string) (metes []Mete, area float64, unit string, err error) {
+	return ParseReportWith(report, DefaultParseOptions)
+}
+
+// ParseReportWith reads a 'metes and bounds report' from AutoCAD. The first line of the report is a caption and is
+// skipped, lines beginning with a call prefix are calls and any other line beginning with 'C' states the area. Calls
+// along a curve are read with the radius, concavity and any radial line given at the end of the call before them.
+// Calls labelled "Curve" are read as in a Civil 3D report, ie Curve 2: Length=47.12', Radius=30.00', Delta=90°00'00",
+// and are rejected if they give no radius rather than being taken for a straight chord.
+func ParseReportWith(report string, opts ParseOptions) (metes []Mete, area float64, unit string, err error) {
+	caption := true
+	prevCall := ""
+	for i, l := range strings.Split(report, "\n") {
+		l, ok := opts.stripComment(l)
+		if !ok {
+			continue
+		}
+		if caption {
+			caption = false
+			continue
+		}
+		if len(l) < 1 {
+			continue
+		}
+		switch {
+		case opts.isCall(l) && strings.Contains(strings.ToUpper(l), "ALONG SAID CURVE"):
+			var prev Mete
+			if len(metes) > 0 {
+				prev = metes[len(metes)-1]
+			}
+			arc, err := parseArcCall(strings.ToUpper(l), strings.ToUpper(prevCall), prev)
+			if err != nil {
+				return nil, 0, "", atLine(err, i+1, "curve", l)
+			}
+			metes = append(metes, arc)
+			prevCall = l
+		case opts.isCall(l) && strings.HasPrefix(strings.ToUpper(l), curvePrefix):
+			if !regCivilRadius.MatchString(l) {
+				return nil, 0, "", &ParseError{Line: i + 1, Field: "curve", Token: l, Err: fmt.Errorf("no radius, so the curve cannot be told from its chord")}
+			}
+			var prev Mete
+			if len(metes) > 0 {
+				prev = metes[len(metes)-1]
+			}
+			arc, err := parseCivilCurve(l, prev)
+			if err != nil {
+				return nil, 0, "", atLine(err, i+1, "curve", l)
+			}
+			metes = append(metes, arc)
+			prevCall = l
+		case opts.isCall(l):
+			var mete LinearMete
+			err = mete.parse(l, opts.Strict)
+			if err != nil {
+				return nil, 0, "", atLine(err, i+1, "call", l)
+			}
+			metes = append(metes, &mete)
+			prevCall = l
+		case l[0] == 'C':
+			values := regKnownArea.FindStringSubmatch(l)
+			if values == nil {
+				values = regArea.FindStringSubmatch(l)
+			}
+			if len(values) != 3 {
+				return nil, 0, "", &ParseError{Line: i + 1, Field: "area", Token: l}
+			}
+			area, err = strconv.ParseFloat(values[1], 64)
+			if err != nil {
+				return nil, 0, "", &ParseError{Line: i + 1, Field: "area", Token: values[1], Err: err}
+			}
+			unit = strings.TrimSpace(values[2])
+			if knownAreaUnit(strings.Join(strings.Fields(unit), " ")) {
+				unit = strings.ToUpper(strings.Join(strings.Fields(unit), " "))
+			}
+			if opts.Strict && !knownAreaUnit(unit) {
+				return nil, 0, "", &ParseError{Line: i + 1, Field: "area unit", Token: unit, Err: fmt.Errorf("unrecognized unit")}
+			}
+		}
+	}
+	return metes, area, unit, nil
+}
+
+// stripComment removes any trailing comment from a line. It reports false for lines which are blank or entirely
+// commented out. A trailing comment must be separated from the content before it by whitespace.
+func (opts ParseOptions) stripComment(line string) (string, bool) {
+	line = strings.TrimSpace(line)
+	for _, prefix := range opts.CommentPrefixes {
+		if prefix == "" {
+			continue
+		}
+		if strings.HasPrefix(line, prefix) {
+			return "", false
+		}
+		if idx := strings.Index(line, " "+prefix); idx != -1 {
+			line = strings.TrimSpace(line[:idx])
+		}
+	}
+	return line, line != ""
+}
+
+// isCall reports whether a line of a report is a call
+func (opts ParseOptions) isCall(line string) bool {
+	prefixes := opts.CallPrefixes
+	if len(prefixes) == 0 {
+		prefixes = defaultCallPrefixes
+	}
+	for _, prefix := range prefixes {
+		if prefix != "" && strings.HasPrefix(line, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// knownAreaUnit reports whether a unit of area is recognized
+func knownAreaUnit(unit string) bool {
+	unit = strings.ToUpper(unit)
+	for _, u := range areaUnits {
+		if unit == u {
+			return true
+		}
+	}
+	return false
+}
+
+// ToReport writes the metes of the description as a metes and bounds report in the form AutoCAD produces, so that
+// ParseReport reads back the same metes. Each curve is introduced at the end of the call before it by its concavity
+// and radius, along with a radial line when it is not tangent. Monuments, common lines and aliquot lines are not
+// part of a report and are left out.
+func (d *Description) ToReport() string {
+	opts := FormatOptions{CurveOrder: RadiusInPreamble}
+	metes := flatten(d.Metes)
+	lines := []string{"[INSERT PREAMBLE/CAPTION]:"}
+	for i, m := range metes {
+		var call string
+		switch mete := m.(type) {
+		case *LinearMete:
+			var b Bearing
+			b.FromAngle(mete.bearing)
+			call = fmt.Sprintf("%s, %.2f %s", strings.Title(strings.ToLower(opts.bearing(&b))), mete.distance, strings.ToLower(mete.unit))
+		case *ArcMete:
+			call = strings.ToLower(mete.DescribeWith(FormatOptions{}))
+		}
+		ending := "the point of beginning"
+		if i+1 < len(metes) {
+			ending = strings.ToLower(preambleWith(metes[i+1], endTangent(m), opts))
+		}
+		lines = append(lines, fmt.Sprintf("THENCE (%d) %s to %s;", i+1, call, ending))
+	}
+	lines = append(lines, fmt.Sprintf("Containing %s %s, more or less.", strconv.FormatFloat(d.Area, 'f', -1, 64), strings.ToLower(d.Unit)))
+	return strings.Join(lines, "\n\n")
+}
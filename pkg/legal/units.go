@@ -0,0 +1,159 @@
+package legal
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Unit is a unit of length
+type Unit int
+
+// Units of length. The international foot and the US survey foot differ by two parts per million, which is enough
+// to matter over state plane coordinates, so they are kept distinct. Chains and rods are Gunter's chains and rods of
+// US survey feet, and varas are Texas varas of 33 1/3 inches.
+const (
+	Feet Unit = iota
+	USSurveyFeet
+	Meters
+	Chains
+	Rods
+	Varas
+)
+
+// unitNames are the normalized names of each unit, as given by normalizeUnit
+var unitNames = [...]string{"FEET", "US SURVEY FEET", "METERS", "CHAINS", "RODS", "VARAS"}
+
+// usSurveyFoot is the length of a US survey foot in meters
+const usSurveyFoot = 1200.0 / 3937.0
+
+// unitMeters is the length of each unit in meters
+var unitMeters = [...]float64{0.3048, usSurveyFoot, 1.0, 66.0 * usSurveyFoot, 16.5 * usSurveyFoot, 100.0 / 36.0 * usSurveyFoot}
+
+// UnitFromString recognizes a unit of length from its name or a common abbreviation
+func UnitFromString(s string) (Unit, bool) {
+	name := normalizeUnit(s)
+	for i, n := range unitNames {
+		if n == name {
+			return Unit(i), true
+		}
+	}
+	return Feet, false
+}
+
+// Describe returns the name of the unit as it is written in a description
+func (u Unit) Describe() string {
+	return unitNames[u]
+}
+
+// ratio is the number of units to in one unit u, by which a length in u is multiplied to give it in to
+func (u Unit) ratio(to Unit) float64 {
+	return unitMeters[u] / unitMeters[to]
+}
+
+// ConvertArea converts an area in square units of one unit of length to square units of another
+func ConvertArea(area float64, from, to Unit) float64 {
+	converted, _ := toAreaUnit(area, "SQUARE "+from.Describe(), "SQUARE "+to.Describe())
+	return converted
+}
+
+// ConvertTo is the mete with its distance given in another unit. A mete whose unit is not recognized is returned
+// unchanged.
+func (m LinearMete) ConvertTo(u Unit) LinearMete {
+	from, ok := UnitFromString(m.unit)
+	if !ok {
+		return m
+	}
+	m.distance *= from.ratio(u)
+	m.unit = u.Describe()
+	return m
+}
+
+// ConvertTo is the arc with its radius given in another unit. An arc whose unit is not recognized is returned
+// unchanged.
+func (am ArcMete) ConvertTo(u Unit) ArcMete {
+	from, ok := UnitFromString(am.unit)
+	if !ok {
+		return am
+	}
+	am.radius *= from.ratio(u)
+	am.unit = u.Describe()
+	return am
+}
+
+// toCommonUnit flattens the metes and converts them all to the unit of the first which states one, so that a traverse
+// mixing units can be computed. Metes without a unit are presumed to be in it. It is an error for the metes to differ
+// in a unit which is not recognized.
+func toCommonUnit(metes []Mete) ([]Mete, string, error) {
+	flat := flatten(metes)
+	unit := ""
+	for _, m := range flat {
+		if u := normalizeUnit(meteUnit(m)); u != "" {
+			unit = u
+			break
+		}
+	}
+	target, known := UnitFromString(unit)
+	converted := make([]Mete, len(flat))
+	for i, m := range flat {
+		u := normalizeUnit(meteUnit(m))
+		if u == "" || u == unit {
+			converted[i] = m
+			continue
+		}
+		if _, ok := UnitFromString(u); !ok || !known {
+			return nil, "", fmt.Errorf("mete %d: unit %s is incompatible with %s", i+1, u, unit)
+		}
+		switch mete := m.(type) {
+		case *LinearMete:
+			c := mete.ConvertTo(target)
+			converted[i] = &c
+		case *ArcMete:
+			c := mete.ConvertTo(target)
+			converted[i] = &c
+		}
+	}
+	return converted, unit, nil
+}
+
+// AreaUnit is a unit of area
+type AreaUnit int
+
+// Units of area
+const (
+	SquareFeet AreaUnit = iota
+	SquareMeters
+	Acres
+	Hectares
+)
+
+// areaUnitNames are the names of each unit of area as they are written in a description
+var areaUnitNames = [...]string{"SQUARE FEET", "SQUARE METERS", "ACRES", "HECTARES"}
+
+// AreaUnitFromString recognizes a unit of area from its name
+func AreaUnitFromString(s string) (AreaUnit, bool) {
+	src, ok := areaConversions[strings.ToUpper(strings.TrimSpace(s))]
+	if !ok {
+		return SquareFeet, false
+	}
+	for i, name := range areaUnitNames {
+		if areaConversions[name] == src {
+			return AreaUnit(i), true
+		}
+	}
+	return SquareFeet, false
+}
+
+// Describe returns the name of the unit of area as it is written in a description
+func (u AreaUnit) Describe() string {
+	return areaUnitNames[u]
+}
+
+// AreaIn is the area of the description converted to another unit, along with the name of that unit. It is an error
+// for the area to be stated in a unit which is not recognized.
+func (d *Description) AreaIn(u AreaUnit) (float64, string, error) {
+	area, err := toAreaUnit(d.Area, d.Unit, u.Describe())
+	if err != nil {
+		return 0.0, "", err
+	}
+	return area, u.Describe(), nil
+}
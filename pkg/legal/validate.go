@@ -0,0 +1,62 @@
+package legal
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// nearTangentTolerance is the largest difference in direction between a curve and the course before it which is
+// presumed to be a curve meant to be tangent. Larger differences are deliberately non-tangent curves.
+const nearTangentTolerance = math.Pi / 10800.0 // one minute of arc
+
+// Validate checks that the description can be written sensibly. It must have a kind and at least one mete, a valid
+// starting corner unless it begins elsewhere, and an area which is not negative. Lines must have a positive distance
+// and arcs a positive radius and a central angle of less than a full circle. An arc which misses being tangent to the
+// course before it by less than a minute of arc is taken to be a curve meant to be tangent whose tangent has drifted,
+// and is an error rather than a non-tangent curve.
+func (d *Description) Validate() error {
+	if strings.TrimSpace(d.Kind) == "" {
+		return fmt.Errorf("description has no kind")
+	}
+	if len(d.Metes) == 0 {
+		return fmt.Errorf("description has no metes")
+	}
+	if d.StartCoordinates == nil && !d.PreTied && (d.Start < North || d.Start > NorthWest) {
+		return fmt.Errorf("invalid starting corner %d", d.Start)
+	}
+	if d.Area < 0.0 || math.IsNaN(d.Area) {
+		return fmt.Errorf("invalid area %v", d.Area)
+	}
+	if d.Centerline && !(d.StripWidth > 0.0) {
+		return fmt.Errorf("centerline has no width")
+	}
+	for i, m := range d.Metes {
+		switch mete := m.(type) {
+		case *LinearMete:
+			if !(mete.distance > 0.0) || math.IsInf(mete.distance, 0) {
+				return fmt.Errorf("mete %d: invalid distance %v", i+1, mete.distance)
+			}
+		case *ArcMete:
+			if !(mete.radius > 0.0) || math.IsInf(mete.radius, 0) {
+				return fmt.Errorf("mete %d: invalid radius %v", i+1, mete.radius)
+			}
+			if !(mete.centralAngle > 0.0 && mete.centralAngle < 2.0*math.Pi) {
+				return fmt.Errorf("mete %d: invalid central angle %s", i+1, dms(mete.centralAngle))
+			}
+			if i == 0 || (d.Commencement && i == 1) {
+				continue
+			}
+			miss := angleDiff(endTangent(d.Metes[i-1]), mete.tangent)
+			if miss > tangencyTolerance && miss < nearTangentTolerance {
+				return fmt.Errorf("mete %d: curve misses being tangent to the course before it by %s", i+1, dms(miss))
+			}
+		}
+	}
+	for i, e := range d.Exceptions {
+		if len(e.Metes) == 0 {
+			return fmt.Errorf("exception %d: description has no metes", i+1)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,15 @@
+package legal
+
+import "io"
+
+// countingWriter passes writes through to w, counting the bytes written
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}